@@ -1,13 +1,19 @@
 package main
 
 import (
+	"context"
 	"html/template"
 	"log"
+	"net/url"
 	"os"
+	"strings"
+	"time"
+	_ "time/tzdata" // Time zones of job preferences are validated against the embedded database
 
 	"devsearch-go/internal/application"
 	"devsearch-go/internal/domain"
 	"devsearch-go/internal/infrastructure"
+	"devsearch-go/internal/infrastructure/jobs"
 	"devsearch-go/internal/infrastructure/middleware"
 	"devsearch-go/internal/infrastructure/utils"
 	"devsearch-go/internal/interfaces/http"
@@ -20,6 +26,27 @@ import (
 	"gorm.io/gorm"
 )
 
+// viewRollupInterval is how often raw page views are folded into the analytics shown to owners.
+const viewRollupInterval = 10 * time.Minute
+
+// savedSearchAlertInterval is how often saved searches with alerts are checked for new matches.
+const savedSearchAlertInterval = time.Hour
+
+// recommendationRefreshInterval is how often similar developers and related projects are recomputed.
+const recommendationRefreshInterval = time.Hour
+
+// scheduledPublishInterval is how often drafts scheduled for publication are checked.
+const scheduledPublishInterval = time.Minute
+
+// trashPurgeInterval is how often projects, skills and accounts kept in the trash too long are purged.
+const trashPurgeInterval = time.Hour
+
+// sourceStatsSyncInterval is how often source links due for a refresh are checked on their code hosts.
+const sourceStatsSyncInterval = 15 * time.Minute
+
+// linkCheckInterval is how often demo, source and social links due for a check are checked.
+const linkCheckInterval = 30 * time.Minute
+
 func main() {
 	// Load .env file
 	if err := godotenv.Load(); err != nil {
@@ -37,10 +64,16 @@ func main() {
 	}
 
 	// Auto-migrate the models
-	err = db.AutoMigrate(&domain.User{}, &domain.Profile{}, &domain.Skill{}, &domain.Message{}, &domain.Project{}, &domain.Tag{}, &domain.Review{})
+	err = db.AutoMigrate(&domain.User{}, &domain.Profile{}, &domain.CatalogSkill{}, &domain.SkillAlias{}, &domain.Skill{}, &domain.Endorsement{}, &domain.Message{}, &domain.Project{}, &domain.Tag{}, &domain.Review{}, &domain.SlugRedirect{}, &domain.PageView{}, &domain.ViewStat{}, &domain.ReferrerStat{}, &domain.Follow{}, &domain.ActivityEvent{}, &domain.Shortlist{}, &domain.ShortlistCandidate{}, &domain.SavedSearch{}, &domain.JobPosting{}, &domain.JobPostingSkill{}, &domain.SimilarProfile{}, &domain.RelatedProject{}, &domain.ProjectCollaborator{}, &domain.ReportCase{}, &domain.Report{}, &domain.AuditEvent{}, &domain.Experience{}, &domain.Education{}, &domain.ProjectRevision{}, &domain.SourceRepoStats{}, &domain.LinkStatus{}, &domain.LinkCheck{})
 	if err != nil {
 		log.Fatalf("Failed to auto-migrate database: %v", err)
 	}
+	// The plain slug index of projects is superseded by the unique one on projects outside the trash
+	if db.Migrator().HasIndex(&domain.Project{}, "idx_projects_slug") {
+		if err := db.Migrator().DropIndex(&domain.Project{}, "idx_projects_slug"); err != nil {
+			log.Fatalf("Failed to drop the old project slug index: %v", err)
+		}
+	}
 
 	// Initialize repositories
 	projectRepo := &infrastructure.GormProjectRepository{DB: db}
@@ -48,25 +81,118 @@ func main() {
 	profileRepo := &infrastructure.GormProfileRepository{DB: db}
 	skillRepo := &infrastructure.GormSkillRepository{DB: db}
 	messageRepo := &infrastructure.GormMessageRepository{DB: db}
+	experienceRepo := &infrastructure.GormExperienceRepository{DB: db}
+	educationRepo := &infrastructure.GormEducationRepository{DB: db}
+	catalogRepo := &infrastructure.GormSkillCatalogRepository{DB: db}
+	endorsementRepo := &infrastructure.GormEndorsementRepository{DB: db}
+	slugRepo := &infrastructure.GormSlugRedirectRepository{DB: db}
+	analyticsRepo := &infrastructure.GormAnalyticsRepository{DB: db}
+	activityRepo := &infrastructure.GormActivityRepository{DB: db}
+	shortlistRepo := &infrastructure.GormShortlistRepository{DB: db}
+	savedSearchRepo := &infrastructure.GormSavedSearchRepository{DB: db}
+	jobPostingRepo := &infrastructure.GormJobPostingRepository{DB: db}
+	recommendationRepo := &infrastructure.GormRecommendationRepository{DB: db}
+	collaboratorRepo := &infrastructure.GormCollaboratorRepository{DB: db}
+	adminRepo := &infrastructure.GormAdminRepository{DB: db}
+	reportRepo := &infrastructure.GormReportRepository{DB: db}
+	auditRepo := &infrastructure.GormAuditRepository{DB: db}
+	trashRepo := &infrastructure.GormTrashRepository{DB: db}
+	revisionRepo := &infrastructure.GormProjectRevisionRepository{DB: db}
+	sourceStatsRepo := &infrastructure.GormSourceStatsRepository{DB: db}
+	linkHealthRepo := &infrastructure.GormLinkHealthRepository{DB: db}
+	repositoryReader := &infrastructure.LocalRepositoryReader{MirrorDir: os.Getenv("GIT_MIRROR_DIR")}
+	sourceHosts := []application.SourceHost{
+		&infrastructure.GitHubSourceHost{Host: "github.com", APIURL: "https://api.github.com", Token: os.Getenv("GITHUB_TOKEN")},
+		&infrastructure.GitLabSourceHost{Host: "gitlab.com", APIURL: "https://gitlab.com/api/v4", Token: os.Getenv("GITLAB_TOKEN")},
+		&infrastructure.GiteaSourceHost{Host: "codeberg.org", APIURL: "https://codeberg.org/api/v1"},
+	}
+	// GITEA_URL adds a self-hosted Gitea or Forgejo instance, such as "https://git.example.com"
+	if giteaURL, err := url.Parse(os.Getenv("GITEA_URL")); err == nil && giteaURL.Host != "" {
+		sourceHosts = append(sourceHosts, &infrastructure.GiteaSourceHost{Host: giteaURL.Host, APIURL: strings.TrimSuffix(giteaURL.String(), "/") + "/api/v1", Token: os.Getenv("GITEA_TOKEN")})
+	}
+	linkChecker := &infrastructure.HTTPLinkChecker{}
+	mediaStore := &infrastructure.LocalMediaStore{Dir: "." + string(os.PathSeparator) + "media"}
+	resumeRenderer := &infrastructure.GofpdfResumeRenderer{MediaDir: "." + string(os.PathSeparator) + "media"}
 
 	// Initialize use cases
-	projectUseCase := application.NewProjectUseCase(projectRepo)
-	userUseCase := application.NewUserUseCase(userRepo, profileRepo, skillRepo, messageRepo)
+	projectUseCase := application.NewProjectUseCase(projectRepo, slugRepo, profileRepo, activityRepo, auditRepo, revisionRepo)
+	userUseCase := application.NewUserUseCase(userRepo, profileRepo, skillRepo, messageRepo, catalogRepo, slugRepo, activityRepo, auditRepo, trashRepo)
+	resumeUseCase := application.NewResumeUseCase(profileRepo, skillRepo, experienceRepo, educationRepo, catalogRepo, resumeRenderer)
+	careerUseCase := application.NewCareerUseCase(profileRepo, experienceRepo, educationRepo)
+	skillCatalogUseCase := application.NewSkillCatalogUseCase(catalogRepo, skillRepo)
+	endorsementUseCase := application.NewEndorsementUseCase(endorsementRepo, skillRepo, profileRepo)
+	analyticsUseCase := application.NewAnalyticsUseCase(analyticsRepo)
+	activityUseCase := application.NewActivityUseCase(activityRepo, profileRepo)
+	shortlistUseCase := application.NewShortlistUseCase(shortlistRepo, profileRepo)
+	savedSearchUseCase := application.NewSavedSearchUseCase(savedSearchRepo, profileRepo, messageRepo)
+	jobPostingUseCase := application.NewJobPostingUseCase(jobPostingRepo, profileRepo, catalogRepo)
+	recommendationUseCase := application.NewRecommendationUseCase(recommendationRepo)
+	collaboratorUseCase := application.NewCollaboratorUseCase(projectRepo, collaboratorRepo, profileRepo, messageRepo)
+	adminUseCase := application.NewAdminUseCase(adminRepo, userRepo, projectRepo, auditRepo, profileRepo)
+	reportUseCase := application.NewReportUseCase(reportRepo, adminRepo, userRepo, projectRepo, profileRepo, messageRepo, auditRepo)
+	auditUseCase := application.NewAuditUseCase(auditRepo, userRepo)
+	projectImportUseCase := application.NewProjectImportUseCase(repositoryReader)
+	sourceStatsUseCase := application.NewSourceStatsUseCase(sourceStatsRepo, sourceHosts)
+	linkHealthUseCase := application.NewLinkHealthUseCase(linkHealthRepo, messageRepo, linkChecker)
+	trashUseCase := application.NewTrashUseCase(trashRepo, projectRepo, profileRepo, slugRepo, auditRepo, mediaStore)
+
+	// "devsearch-go grant-admin <username>" makes an existing user an admin, to bootstrap the admin console
+	if len(os.Args) > 1 && os.Args[1] == "grant-admin" {
+		if len(os.Args) != 3 {
+			log.Fatal("Usage: devsearch-go grant-admin <username>")
+		}
+		user, err := adminUseCase.GrantAdmin(os.Args[2], application.RequestMeta{UserAgent: "grant-admin command"})
+		if err != nil {
+			log.Fatalf("Failed to grant admin role: %v", err)
+		}
+		log.Printf("%s is now an admin", user.Username)
+		return
+	}
+
+	// Seed the skill catalog and map free-text skills onto it
+	if err := skillCatalogUseCase.SeedCatalog(application.DefaultSkillCatalog); err != nil {
+		log.Fatalf("Failed to seed skill catalog: %v", err)
+	}
+	if migrated, err := skillCatalogUseCase.MigrateSkills(); err != nil {
+		log.Printf("Failed to map skills to the catalog: %v", err)
+	} else if migrated > 0 {
+		log.Printf("Mapped %d skills to the skill catalog", migrated)
+	}
+
+	// Give projects created before slugs existed a shareable URL
+	if updated, err := projectUseCase.BackfillProjectSlugs(); err != nil {
+		log.Printf("Failed to backfill project slugs: %v", err)
+	} else if updated > 0 {
+		log.Printf("Added slugs to %d projects", updated)
+	}
+
+	// Start background jobs
+	scheduler := jobs.NewScheduler()
+	scheduler.Every(viewRollupInterval, "view rollup", analyticsUseCase.RollupViews)
+	scheduler.Every(savedSearchAlertInterval, "saved search alerts", savedSearchUseCase.SendAlerts)
+	scheduler.Every(recommendationRefreshInterval, "recommendations", recommendationUseCase.RefreshRecommendations)
+	scheduler.Every(scheduledPublishInterval, "scheduled publishing", projectUseCase.PublishScheduledProjects)
+	scheduler.Every(trashPurgeInterval, "trash purge", trashUseCase.PurgeExpired)
+	scheduler.Every(sourceStatsSyncInterval, "source stats sync", sourceStatsUseCase.SyncSourceStats)
+	scheduler.Every(linkCheckInterval, "link health check", linkHealthUseCase.CheckLinks)
+	scheduler.Start(context.Background())
 
 	// Initialize HTTP handlers
-	h := &http.Handler{ProjectUseCase: projectUseCase, UserUseCase: userUseCase}
+	h := &http.Handler{ProjectUseCase: projectUseCase, UserUseCase: userUseCase, ResumeUseCase: resumeUseCase, CareerUseCase: careerUseCase, SkillCatalogUseCase: skillCatalogUseCase, EndorsementUseCase: endorsementUseCase, AnalyticsUseCase: analyticsUseCase, ActivityUseCase: activityUseCase, ShortlistUseCase: shortlistUseCase, SavedSearchUseCase: savedSearchUseCase, JobPostingUseCase: jobPostingUseCase, RecommendationUseCase: recommendationUseCase, CollaboratorUseCase: collaboratorUseCase, AdminUseCase: adminUseCase, ReportUseCase: reportUseCase, AuditUseCase: auditUseCase, TrashUseCase: trashUseCase, ProjectImportUseCase: projectImportUseCase, LinkHealthUseCase: linkHealthUseCase}
 
 	router := gin.Default()
 
 	// Configure sessions
 	cookieStore := cookie.NewStore([]byte(os.Getenv("SESSION_SECRET")))
 	router.Use(sessions.Sessions("devsearch_session", cookieStore))
+	router.Use(middleware.ActiveUser(adminUseCase.AccountStatus))
 
 	// Register custom template functions
 	router.SetFuncMap(template.FuncMap{
 		"pluralize":    utils.Pluralize,
 		"sliceString":  utils.SliceString,
 		"linebreaksbr": utils.Linebreaksbr,
+		"formatDate":   utils.FormatDate,
 	})
 
 	// Load HTML templates
@@ -74,6 +200,7 @@ func main() {
 		"pluralize":    utils.Pluralize,
 		"sliceString":  utils.SliceString,
 		"linebreaksbr": utils.Linebreaksbr,
+		"formatDate":   utils.FormatDate,
 	})
 	template.Must(t.ParseGlob("templates/**/*.html"))
 	router.SetHTMLTemplate(t)
@@ -86,6 +213,8 @@ func main() {
 	api := router.Group("/api")
 	{
 		api.GET("/projects", h.GetProjects)
+		api.GET("/projects/:id/related", h.GetRelatedProjects)
+		api.GET("/projects/:id/collaborators", h.GetProjectCollaborators)
 		// The following handlers are commented out because they are not defined on http.Handler
 		// api.GET("/projects/:id", h.GetProject)
 		// api.POST("/projects", h.CreateProject)
@@ -98,14 +227,39 @@ func main() {
 	{
 		userAPI.GET("/profiles", h.GetProfiles)
 		userAPI.GET("/profiles/:id", h.GetUserProfile)
+		userAPI.GET("/u/:username", h.GetUserProfileByUsername)
+		userAPI.GET("/profiles/:id/resume", h.GetJSONResume)
+		userAPI.GET("/profiles/:id/similar", h.GetSimilarProfiles)
 		userAPI.POST("/register", h.RegisterUser)
 		userAPI.POST("/login", h.LoginUser)
 		userAPI.POST("/logout", h.LogoutUser)
 		userAPI.GET("/account", h.GetUserAccount)
 		userAPI.PUT("/account/:id", h.UpdateUserAccount)
+		userAPI.PUT("/account/preferences", h.UpdateJobPreferences)
+		userAPI.PUT("/account/privacy", h.UpdatePrivacy)
 		userAPI.POST("/skills", h.CreateSkill)
 		userAPI.PUT("/skills/:id", h.UpdateSkill)
 		userAPI.DELETE("/skills/:id", h.DeleteSkill)
+		userAPI.GET("/skill-catalog", h.SearchSkillCatalog)
+		userAPI.POST("/skills/:id/endorsements", h.EndorseSkill)
+		userAPI.DELETE("/skills/:id/endorsements", h.RevokeEndorsement)
+		userAPI.POST("/profiles/:id/follow", h.FollowProfile)
+		userAPI.DELETE("/profiles/:id/follow", h.UnfollowProfile)
+		userAPI.GET("/feed", h.GetFeed)
+		userAPI.GET("/shortlists", h.GetShortlists)
+		userAPI.GET("/saved-searches", h.GetSavedSearches)
+		userAPI.GET("/jobs", h.GetJobPostings)
+		userAPI.GET("/jobs/:id", h.GetJobPosting)
+		userAPI.GET("/jobs/:id/matches", h.GetJobPostingMatches)
+		userAPI.GET("/account/job-matches", h.GetJobMatches)
+		userAPI.GET("/invitations", h.GetInvitations)
+		userAPI.GET("/shortlists/:id/export", h.ExportShortlistCSV)
+		userAPI.POST("/experiences", h.CreateExperience)
+		userAPI.PUT("/experiences/:id", h.UpdateExperience)
+		userAPI.DELETE("/experiences/:id", h.DeleteExperience)
+		userAPI.POST("/educations", h.CreateEducation)
+		userAPI.PUT("/educations/:id", h.UpdateEducation)
+		userAPI.DELETE("/educations/:id", h.DeleteEducation)
 		userAPI.GET("/inbox", h.GetInbox)
 		userAPI.GET("/messages/:id", h.GetMessage)
 		userAPI.POST("/messages", h.CreateMessage)
@@ -115,16 +269,27 @@ func main() {
 	router.GET("/", h.RenderProjectsPage) // This will render the projects list page
 	router.GET("/projects", h.RenderProjectsPage)
 	router.GET("/project/:id", h.RenderSingleProjectPage)
+	router.GET("/p/:slug", h.RenderProjectBySlugPage)
 
 	authRequired := router.Group("/")
 	authRequired.Use(middleware.AuthRequired())
 	{
 		authRequired.GET("/create-project", h.RenderCreateProjectPage)
 		authRequired.POST("/create-project", h.CreateProject)
+		authRequired.GET("/import-project", h.RenderImportProjectPage)
+		authRequired.GET("/link-health", h.RenderLinkHealthPage)
+		authRequired.POST("/import-project", h.ImportProject)
 		authRequired.GET("/update-project/:id", h.RenderUpdateProjectPage)
 		authRequired.POST("/update-project/:id", h.UpdateProject)
 		authRequired.GET("/delete-project/:id", h.RenderDeleteProjectPage)
 		authRequired.POST("/delete-project/:id", h.DeleteProject)
+		authRequired.POST("/project/:id", h.CreateReview)
+		authRequired.POST("/project/:id/collaborators", h.InviteCollaborator)
+		authRequired.POST("/project/:id/revisions/:revisionID/revert", h.RevertProject)
+		authRequired.POST("/remove-collaborator/:id", h.RemoveCollaborator)
+		authRequired.POST("/accept-invitation/:id", h.AcceptInvitation)
+		authRequired.POST("/decline-invitation/:id", h.DeclineInvitation)
+		authRequired.POST("/report", h.CreateReport)
 
 		authRequired.GET("/account", h.RenderAccountPage)
 		authRequired.GET("/edit-account", h.RenderEditAccountPage)
@@ -135,15 +300,86 @@ func main() {
 		authRequired.POST("/update-skill/:id", h.UpdateSkill)
 		authRequired.GET("/delete-skill/:id", h.RenderDeleteSkillPage)
 		authRequired.POST("/delete-skill/:id", h.DeleteSkill)
+		authRequired.POST("/trash/project/:id/restore", h.RestoreProject)
+		authRequired.POST("/trash/skill/:id/restore", h.RestoreSkill)
+		authRequired.GET("/delete-account", h.RenderDeleteAccountPage)
+		authRequired.POST("/delete-account", h.DeleteAccount)
+		authRequired.POST("/endorse-skill/:id", h.EndorseSkill)
+		authRequired.POST("/revoke-endorsement/:id", h.RevokeEndorsement)
+		authRequired.POST("/follow/:id", h.FollowProfile)
+		authRequired.POST("/unfollow/:id", h.UnfollowProfile)
+		authRequired.GET("/feed", h.RenderFeedPage)
+		authRequired.GET("/shortlists", h.RenderShortlistsPage)
+		authRequired.POST("/create-shortlist", h.CreateShortlist)
+		authRequired.GET("/shortlist/:id", h.RenderShortlistPage)
+		authRequired.GET("/shortlist/:id/export.csv", h.ExportShortlistCSV)
+		authRequired.POST("/update-shortlist/:id", h.RenameShortlist)
+		authRequired.POST("/delete-shortlist/:id", h.DeleteShortlist)
+		authRequired.POST("/add-to-shortlist/:id", h.AddToShortlist)
+		authRequired.POST("/update-candidate/:id", h.UpdateCandidate)
+		authRequired.POST("/remove-candidate/:id", h.RemoveCandidate)
+		authRequired.GET("/saved-searches", h.RenderSavedSearchesPage)
+		authRequired.POST("/save-search", h.SaveSearch)
+		authRequired.GET("/saved-search/:id", h.OpenSavedSearch)
+		authRequired.POST("/saved-search/:id/alerts", h.UpdateSavedSearchAlerts)
+		authRequired.POST("/delete-saved-search/:id", h.DeleteSavedSearch)
+		authRequired.GET("/create-job", h.RenderCreateJobPostingPage)
+		authRequired.POST("/create-job", h.CreateJobPosting)
+		authRequired.GET("/update-job/:id", h.RenderUpdateJobPostingPage)
+		authRequired.POST("/update-job/:id", h.UpdateJobPosting)
+		authRequired.GET("/delete-job/:id", h.RenderDeleteJobPostingPage)
+		authRequired.POST("/delete-job/:id", h.DeleteJobPosting)
+		authRequired.GET("/create-experience", h.RenderCreateExperiencePage)
+		authRequired.POST("/create-experience", h.CreateExperience)
+		authRequired.GET("/update-experience/:id", h.RenderUpdateExperiencePage)
+		authRequired.POST("/update-experience/:id", h.UpdateExperience)
+		authRequired.GET("/delete-experience/:id", h.RenderDeleteExperiencePage)
+		authRequired.POST("/delete-experience/:id", h.DeleteExperience)
+		authRequired.GET("/create-education", h.RenderCreateEducationPage)
+		authRequired.POST("/create-education", h.CreateEducation)
+		authRequired.GET("/update-education/:id", h.RenderUpdateEducationPage)
+		authRequired.POST("/update-education/:id", h.UpdateEducation)
+		authRequired.GET("/delete-education/:id", h.RenderDeleteEducationPage)
+		authRequired.POST("/delete-education/:id", h.DeleteEducation)
+		authRequired.GET("/import-resume", h.RenderImportResumePage)
+		authRequired.POST("/import-resume", h.ImportResume)
+		authRequired.GET("/edit-preferences", h.RenderJobPreferencesPage)
+		authRequired.POST("/edit-preferences", h.UpdateJobPreferences)
+		authRequired.GET("/edit-privacy", h.RenderPrivacyPage)
+		authRequired.POST("/edit-privacy", h.UpdatePrivacy)
 		authRequired.GET("/inbox", h.RenderInboxPage)
 		authRequired.GET("/message/:id", h.RenderMessagePage)
 		authRequired.GET("/create-message/:id", h.RenderCreateMessagePage)
 		authRequired.POST("/create-message/:id", h.CreateMessage)
 	}
 
+	// Admin console routes
+	admin := router.Group("/admin")
+	admin.Use(middleware.AuthRequired(), middleware.StaffRequired())
+	{
+		admin.GET("", h.RenderAdminPage)
+		admin.GET("/users", h.RenderAdminUsersPage)
+		admin.POST("/users/:id/suspend", h.SuspendUser)
+		admin.POST("/users/:id/reinstate", h.ReinstateUser)
+		admin.POST("/users/:id/role", h.UpdateUserRole)
+		admin.GET("/projects", h.RenderAdminProjectsPage)
+		admin.POST("/projects/:id/hide", h.HideProject)
+		admin.POST("/projects/:id/unhide", h.UnhideProject)
+		admin.GET("/reviews", h.RenderAdminReviewsPage)
+		admin.POST("/reviews/:id/delete", h.DeleteReviewAsStaff)
+		admin.GET("/reports", h.RenderAdminReportsPage)
+		admin.POST("/reports/:id/resolve", h.ResolveReportCase)
+		admin.GET("/audit", h.RenderAdminAuditPage)
+		admin.GET("/audit/export", h.ExportAuditLogCSV)
+	}
+
 	// Public User HTML routes
 	router.GET("/profiles", h.RenderProfilesPage)
 	router.GET("/profile/:id", h.RenderUserProfilePage)
+	router.GET("/u/:username", h.RenderUserProfileByUsernamePage)
+	router.GET("/profile/:id/resume.pdf", h.GetResumePDF)
+	router.GET("/jobs", h.RenderJobPostingsPage)
+	router.GET("/job/:id", h.RenderJobPostingPage)
 	router.GET("/login", h.RenderLoginRegisterPage)
 	router.POST("/login", h.LoginUser)
 	router.GET("/register", h.RenderLoginRegisterPage)
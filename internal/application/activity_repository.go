@@ -0,0 +1,27 @@
+package application
+
+import (
+	"devsearch-go/internal/domain"
+
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// FeedCursor is the position of the last event of a feed page; the next page starts after it.
+type FeedCursor struct {
+	CreatedAt time.Time
+	ID        uuid.UUID
+}
+
+// ActivityRepository defines the interface for data operations on follows and activity events.
+type ActivityRepository interface {
+	CreateActivityEvent(event *domain.ActivityEvent) error
+	CreateFollow(follow *domain.Follow) error
+	FindFollow(followerID, followedID uuid.UUID) (*domain.Follow, error)
+	DeleteFollow(id uuid.UUID) error
+	CountFollowers(profileID uuid.UUID) (int64, error)
+	// FindFeedEvents retrieves events of the profiles followerID follows, newest first,
+	// starting after the cursor when one is given.
+	FindFeedEvents(followerID uuid.UUID, after *FeedCursor, limit int) ([]domain.ActivityEvent, error)
+}
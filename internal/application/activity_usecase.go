@@ -0,0 +1,193 @@
+package application
+
+import (
+	"devsearch-go/internal/domain"
+
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// DefaultFeedPageSize is the number of feed events returned when no limit is requested.
+const DefaultFeedPageSize = 20
+
+// maxFeedPageSize bounds the number of feed events returned per page.
+const maxFeedPageSize = 100
+
+var (
+	// ErrSelfFollow is returned when a developer tries to follow their own profile.
+	ErrSelfFollow = errors.New("you cannot follow yourself")
+	// ErrAlreadyFollowing is returned when the user already follows the profile.
+	ErrAlreadyFollowing = errors.New("you already follow this developer")
+	// ErrNotFollowing is returned when unfollowing a profile the user does not follow.
+	ErrNotFollowing = errors.New("you do not follow this developer")
+	// ErrInvalidCursor is returned when a feed cursor cannot be decoded.
+	ErrInvalidCursor = errors.New("invalid feed cursor")
+)
+
+// FeedPage is a page of a user's activity feed. NextCursor is empty on the last page.
+type FeedPage struct {
+	Events     []domain.ActivityEvent
+	NextCursor string
+}
+
+// ActivityUseCase defines the business logic for following developers and their activity feed.
+type ActivityUseCase struct {
+	ActivityRepo ActivityRepository
+	ProfileRepo  ProfileRepository
+}
+
+// NewActivityUseCase creates a new ActivityUseCase.
+func NewActivityUseCase(activityRepo ActivityRepository, profileRepo ProfileRepository) *ActivityUseCase {
+	return &ActivityUseCase{
+		ActivityRepo: activityRepo,
+		ProfileRepo:  profileRepo,
+	}
+}
+
+// FollowProfile makes the user follow a profile. It returns the followed profile so callers
+// can link back to it; the profile is nil only when it or the user's profile could not be loaded.
+func (uc *ActivityUseCase) FollowProfile(userID, profileID uuid.UUID) (*domain.Profile, error) {
+	follower, followed, err := uc.loadFollowPair(userID, profileID)
+	if err != nil {
+		return nil, err
+	}
+	if follower.ID == followed.ID {
+		return followed, ErrSelfFollow
+	}
+	if _, err := uc.ActivityRepo.FindFollow(follower.ID, followed.ID); err == nil {
+		return followed, ErrAlreadyFollowing
+	}
+
+	follow := domain.Follow{FollowerID: follower.ID, FollowedID: followed.ID}
+	if err := uc.ActivityRepo.CreateFollow(&follow); err != nil {
+		return followed, fmt.Errorf("failed to follow profile: %w", err)
+	}
+	return followed, nil
+}
+
+// UnfollowProfile stops the user from following a profile, returning the profile like FollowProfile.
+func (uc *ActivityUseCase) UnfollowProfile(userID, profileID uuid.UUID) (*domain.Profile, error) {
+	follower, followed, err := uc.loadFollowPair(userID, profileID)
+	if err != nil {
+		return nil, err
+	}
+	follow, err := uc.ActivityRepo.FindFollow(follower.ID, followed.ID)
+	if err != nil {
+		return followed, ErrNotFollowing
+	}
+	if err := uc.ActivityRepo.DeleteFollow(follow.ID); err != nil {
+		return followed, fmt.Errorf("failed to unfollow profile: %w", err)
+	}
+	return followed, nil
+}
+
+// IsFollowing reports whether the user follows a profile. Anonymous users follow nobody.
+func (uc *ActivityUseCase) IsFollowing(userID, profileID uuid.UUID) bool {
+	if userID == uuid.Nil {
+		return false
+	}
+	follower, err := uc.ProfileRepo.FindProfileByUserID(userID)
+	if err != nil {
+		return false
+	}
+	_, err = uc.ActivityRepo.FindFollow(follower.ID, profileID)
+	return err == nil
+}
+
+// CountFollowers returns the number of developers following a profile.
+func (uc *ActivityUseCase) CountFollowers(profileID uuid.UUID) (int64, error) {
+	return uc.ActivityRepo.CountFollowers(profileID)
+}
+
+// GetFeed returns a page of events from the profiles the user follows, newest first.
+// cursor is the NextCursor of the previous page, or empty for the first page.
+func (uc *ActivityUseCase) GetFeed(userID uuid.UUID, cursor string, limit int) (*FeedPage, error) {
+	if limit <= 0 {
+		limit = DefaultFeedPageSize
+	}
+	limit = min(limit, maxFeedPageSize)
+
+	var after *FeedCursor
+	if cursor != "" {
+		decoded, err := decodeFeedCursor(cursor)
+		if err != nil {
+			return nil, err
+		}
+		after = decoded
+	}
+
+	follower, err := uc.ProfileRepo.FindProfileByUserID(userID)
+	if err != nil {
+		return nil, fmt.Errorf("profile not found for user: %w", err)
+	}
+
+	// One extra event tells whether another page follows
+	events, err := uc.ActivityRepo.FindFeedEvents(follower.ID, after, limit+1)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load feed: %w", err)
+	}
+
+	page := &FeedPage{Events: events}
+	if len(events) > limit {
+		page.Events = events[:limit]
+		last := page.Events[limit-1]
+		page.NextCursor = encodeFeedCursor(FeedCursor{CreatedAt: last.CreatedAt, ID: last.ID})
+	}
+	return page, nil
+}
+
+// loadFollowPair loads the user's profile and the profile they want to (un)follow.
+// Private profiles cannot be followed by anyone but their owner, who is turned away as a self-follow.
+func (uc *ActivityUseCase) loadFollowPair(userID, profileID uuid.UUID) (*domain.Profile, *domain.Profile, error) {
+	follower, err := uc.ProfileRepo.FindProfileByUserID(userID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("profile not found for user: %w", err)
+	}
+	followed, err := uc.ProfileRepo.FindProfileByID(profileID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("profile not found: %w", err)
+	}
+	if followed.Privacy.Mode == domain.ProfileModePrivate && followed.ID != follower.ID {
+		return nil, nil, ErrProfileNotVisible
+	}
+	return follower, followed, nil
+}
+
+// encodeFeedCursor turns a feed position into an opaque, URL-safe cursor.
+func encodeFeedCursor(cursor FeedCursor) string {
+	raw := strconv.FormatInt(cursor.CreatedAt.UnixMicro(), 10) + ":" + cursor.ID.String()
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+// decodeFeedCursor reverses encodeFeedCursor.
+func decodeFeedCursor(cursor string) (*FeedCursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+	micros, idStr, ok := strings.Cut(string(raw), ":")
+	if !ok {
+		return nil, ErrInvalidCursor
+	}
+	createdAt, err := strconv.ParseInt(micros, 10, 64)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+	return &FeedCursor{CreatedAt: time.UnixMicro(createdAt).UTC(), ID: id}, nil
+}
+
+// recordActivity appends an event to its actor's activity log. The event is a side effect of
+// the change that caused it, so failing to record it does not fail that change.
+func recordActivity(activityRepo ActivityRepository, event *domain.ActivityEvent) {
+	_ = activityRepo.CreateActivityEvent(event)
+}
@@ -0,0 +1,23 @@
+package application
+
+import (
+	"devsearch-go/internal/domain"
+
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// AdminRepository defines the interface for the data operations of the admin console.
+type AdminRepository interface {
+	FindUsersForAdmin(query string, page, limit int) ([]domain.User, int64, error)
+	FindProjectsForAdmin(query string, page, limit int) ([]domain.Project, int64, error)
+	FindReviewsForAdmin(query string, page, limit int) ([]domain.Review, int64, error)
+	FindReviewByID(id uuid.UUID) (*domain.Review, error)
+	UpdateUserRole(userID uuid.UUID, role string) error
+	SetUserSuspended(userID uuid.UUID, suspendedAt *time.Time) error
+	SetProjectHidden(projectID uuid.UUID, hiddenAt *time.Time) error
+	SetProfileHidden(profileID uuid.UUID, hiddenAt *time.Time) error
+	SetReviewHidden(reviewID uuid.UUID, hiddenAt *time.Time) error
+	DeleteReview(id uuid.UUID) error
+}
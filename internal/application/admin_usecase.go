@@ -0,0 +1,228 @@
+package application
+
+import (
+	"devsearch-go/internal/domain"
+
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	// ErrInvalidUserRole is returned for a role outside domain.UserRoles.
+	ErrInvalidUserRole = errors.New("role must be user, moderator or admin")
+	// ErrUserNotFound is returned for users that do not exist.
+	ErrUserNotFound = errors.New("user not found")
+	// ErrReviewNotFound is returned for reviews that do not exist.
+	ErrReviewNotFound = errors.New("review not found")
+)
+
+// AdminUseCase defines the business logic of the admin console. Every method checks that the
+// acting user is staff and may perform the action on the resource.
+type AdminUseCase struct {
+	AdminRepo   AdminRepository
+	UserRepo    UserRepository
+	ProjectRepo ProjectRepository
+	AuditRepo   AuditRepository
+	ProfileRepo ProfileRepository
+}
+
+// NewAdminUseCase creates a new AdminUseCase.
+func NewAdminUseCase(adminRepo AdminRepository, userRepo UserRepository, projectRepo ProjectRepository, auditRepo AuditRepository, profileRepo ProfileRepository) *AdminUseCase {
+	return &AdminUseCase{
+		AdminRepo:   adminRepo,
+		UserRepo:    userRepo,
+		ProjectRepo: projectRepo,
+		AuditRepo:   auditRepo,
+		ProfileRepo: profileRepo,
+	}
+}
+
+// AccountStatus returns the role of a user and whether their account is still active, that is
+// neither suspended nor deleted. A deleted account keeps its user until it is purged, but its
+// profile is in the trash.
+func (uc *AdminUseCase) AccountStatus(userID uuid.UUID) (role string, active bool) {
+	user, err := uc.UserRepo.FindUserByID(userID)
+	if err != nil || user.IsSuspended() {
+		return "", false
+	}
+	if _, err := uc.ProfileRepo.FindProfileByUserID(userID); err != nil {
+		return "", false
+	}
+	return user.Role, true
+}
+
+// GetUsers retrieves users matching the search on their username, name or email, newest first.
+func (uc *AdminUseCase) GetUsers(actorID uuid.UUID, query string, page, limit int) ([]domain.User, int64, error) {
+	if _, err := loadStaffPrincipal(uc.UserRepo, actorID); err != nil {
+		return nil, 0, err
+	}
+	return uc.AdminRepo.FindUsersForAdmin(strings.TrimSpace(query), page, limit)
+}
+
+// GetProjects retrieves projects matching the search on their title, hidden ones included, newest first.
+func (uc *AdminUseCase) GetProjects(actorID uuid.UUID, query string, page, limit int) ([]domain.Project, int64, error) {
+	if _, err := loadStaffPrincipal(uc.UserRepo, actorID); err != nil {
+		return nil, 0, err
+	}
+	return uc.AdminRepo.FindProjectsForAdmin(strings.TrimSpace(query), page, limit)
+}
+
+// GetReviews retrieves reviews matching the search on their body or project title, newest first.
+func (uc *AdminUseCase) GetReviews(actorID uuid.UUID, query string, page, limit int) ([]domain.Review, int64, error) {
+	if _, err := loadStaffPrincipal(uc.UserRepo, actorID); err != nil {
+		return nil, 0, err
+	}
+	return uc.AdminRepo.FindReviewsForAdmin(strings.TrimSpace(query), page, limit)
+}
+
+// SetUserSuspended suspends or reinstates a user account. Suspended users cannot log in and
+// are signed out of existing sessions.
+func (uc *AdminUseCase) SetUserSuspended(actorID, userID uuid.UUID, suspended bool, meta RequestMeta) (*domain.User, error) {
+	principal, err := loadStaffPrincipal(uc.UserRepo, actorID)
+	if err != nil {
+		return nil, err
+	}
+	user, err := uc.UserRepo.FindUserByID(userID)
+	if err != nil {
+		return nil, ErrUserNotFound
+	}
+	if !Can(principal, ActionSuspend, user) {
+		return user, ErrPermissionDenied
+	}
+	before := *user
+
+	user.SuspendedAt = nil
+	action := domain.AuditActionUserReinstate
+	if suspended {
+		now := time.Now()
+		user.SuspendedAt = &now
+		action = domain.AuditActionUserSuspend
+	}
+	if err := uc.AdminRepo.SetUserSuspended(user.ID, user.SuspendedAt); err != nil {
+		return user, fmt.Errorf("failed to update user: %w", err)
+	}
+	uc.recordUserAudit(action, actorID, user, auditChanges(&before, user), meta)
+	return user, nil
+}
+
+// SetUserRole changes the role of another user. Only admins can change roles.
+func (uc *AdminUseCase) SetUserRole(actorID, userID uuid.UUID, role string, meta RequestMeta) (*domain.User, error) {
+	if !contains(domain.UserRoles, role) {
+		return nil, ErrInvalidUserRole
+	}
+	principal, err := loadStaffPrincipal(uc.UserRepo, actorID)
+	if err != nil {
+		return nil, err
+	}
+	user, err := uc.UserRepo.FindUserByID(userID)
+	if err != nil {
+		return nil, ErrUserNotFound
+	}
+	if !Can(principal, ActionManageRoles, user) {
+		return user, ErrPermissionDenied
+	}
+	before := *user
+
+	user.Role = role
+	if err := uc.AdminRepo.UpdateUserRole(user.ID, role); err != nil {
+		return user, fmt.Errorf("failed to update user: %w", err)
+	}
+	uc.recordUserAudit(domain.AuditActionUserRole, actorID, user, auditChanges(&before, user), meta)
+	return user, nil
+}
+
+// SetProjectHidden hides a project from public listings and its page, or makes it visible again.
+func (uc *AdminUseCase) SetProjectHidden(actorID, projectID uuid.UUID, hidden bool, meta RequestMeta) (*domain.Project, error) {
+	principal, err := loadStaffPrincipal(uc.UserRepo, actorID)
+	if err != nil {
+		return nil, err
+	}
+	project, err := uc.ProjectRepo.FindProjectByID(projectID)
+	if err != nil {
+		return nil, ErrProjectNotFound
+	}
+	if !Can(principal, ActionHide, project) {
+		return project, ErrPermissionDenied
+	}
+	before := *project
+
+	project.HiddenAt = nil
+	action := domain.AuditActionProjectUnhide
+	if hidden {
+		now := time.Now()
+		project.HiddenAt = &now
+		action = domain.AuditActionProjectHide
+	}
+	if err := uc.AdminRepo.SetProjectHidden(project.ID, project.HiddenAt); err != nil {
+		return project, fmt.Errorf("failed to update project: %w", err)
+	}
+	recordAudit(uc.AuditRepo, meta, &domain.AuditEvent{
+		ActorID:     auditActor(actorID),
+		Action:      action,
+		TargetKind:  domain.AuditTargetProject,
+		TargetID:    &project.ID,
+		TargetLabel: project.Title,
+		Changes:     auditChanges(&before, project),
+	})
+	return project, nil
+}
+
+// DeleteReview deletes a review and recounts the votes of its project.
+func (uc *AdminUseCase) DeleteReview(actorID, reviewID uuid.UUID, meta RequestMeta) error {
+	principal, err := loadStaffPrincipal(uc.UserRepo, actorID)
+	if err != nil {
+		return err
+	}
+	review, err := uc.AdminRepo.FindReviewByID(reviewID)
+	if err != nil {
+		return ErrReviewNotFound
+	}
+	if !Can(principal, ActionDelete, review) {
+		return ErrPermissionDenied
+	}
+
+	if err := uc.AdminRepo.DeleteReview(review.ID); err != nil {
+		return fmt.Errorf("failed to delete review: %w", err)
+	}
+	recordAudit(uc.AuditRepo, meta, &domain.AuditEvent{
+		ActorID:     auditActor(actorID),
+		Action:      domain.AuditActionReviewDelete,
+		TargetKind:  domain.AuditTargetReview,
+		TargetID:    &review.ID,
+		TargetLabel: "Review by " + review.Owner.Username + " of " + review.Project.Title,
+		Changes:     auditChanges(review, nil),
+	})
+	return recountProjectVotes(uc.ProjectRepo, &domain.Project{ID: review.ProjectID})
+}
+
+// GrantAdmin makes the user with the given username an admin. It performs no permission check
+// and is meant for the command line, to create the first admin, so the audit event has no actor.
+func (uc *AdminUseCase) GrantAdmin(username string, meta RequestMeta) (*domain.User, error) {
+	user, err := uc.UserRepo.FindUserByUsername(strings.TrimSpace(username))
+	if err != nil {
+		return nil, ErrUserNotFound
+	}
+	before := *user
+	user.Role = domain.UserRoleAdmin
+	if err := uc.AdminRepo.UpdateUserRole(user.ID, user.Role); err != nil {
+		return nil, fmt.Errorf("failed to update user: %w", err)
+	}
+	uc.recordUserAudit(domain.AuditActionUserRole, uuid.Nil, user, auditChanges(&before, user), meta)
+	return user, nil
+}
+
+// recordUserAudit records a staff action on a user account in the audit log.
+func (uc *AdminUseCase) recordUserAudit(action string, actorID uuid.UUID, user *domain.User, changes string, meta RequestMeta) {
+	recordAudit(uc.AuditRepo, meta, &domain.AuditEvent{
+		ActorID:     auditActor(actorID),
+		Action:      action,
+		TargetKind:  domain.AuditTargetUser,
+		TargetID:    &user.ID,
+		TargetLabel: user.Username,
+		Changes:     changes,
+	})
+}
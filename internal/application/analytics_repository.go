@@ -0,0 +1,27 @@
+package application
+
+import (
+	"devsearch-go/internal/domain"
+
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ReferrerCount is the number of unique visitors a referring site sent to a page.
+type ReferrerCount struct {
+	TargetID uuid.UUID
+	Referrer string
+	Views    int
+}
+
+// AnalyticsRepository defines the interface for data operations on page views and their daily rollups.
+type AnalyticsRepository interface {
+	// RecordPageView stores a raw view, ignoring views already recorded for the visitor that day.
+	RecordPageView(view *domain.PageView) error
+	// RollupPageViews recomputes the daily view and referrer stats of every day that still has raw views.
+	RollupPageViews() error
+	PurgePageViews(before time.Time) error
+	FindViewStats(kind string, targetIDs []uuid.UUID, since time.Time) ([]domain.ViewStat, error)
+	FindReferrerCounts(kind string, targetIDs []uuid.UUID, since time.Time) ([]ReferrerCount, error)
+}
@@ -0,0 +1,233 @@
+package application
+
+import (
+	"devsearch-go/internal/domain"
+
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// AnalyticsWindowDays is the number of days covered by the analytics shown to owners.
+const AnalyticsWindowDays = 30
+
+// pageViewRetentionDays is how long raw page views are kept before only their rollups remain.
+const pageViewRetentionDays = 7
+
+// topReferrersLimit is the number of referring sites listed per page.
+const topReferrersLimit = 5
+
+// botUserAgentMarkers are lowercase user agent fragments of crawlers, link previews and scripted clients.
+var botUserAgentMarkers = []string{
+	"bot", "crawl", "spider", "slurp", "preview", "facebookexternalhit", "headless",
+	"lighthouse", "curl", "wget", "python-requests", "go-http-client", "okhttp", "java/", "monitor",
+}
+
+// Visitor describes who is viewing a page, as far as analytics needs to know.
+type Visitor struct {
+	UserID    uuid.UUID // uuid.Nil for anonymous visitors
+	IP        string
+	UserAgent string
+	Referrer  string // raw Referer header
+}
+
+// DailyViews is the number of unique visitors of a page on one day.
+// Percent is relative to the busiest day of the series and sizes the chart bar.
+type DailyViews struct {
+	Day     time.Time
+	Views   int
+	Percent int
+}
+
+// ViewSeries is the view history of a single profile or project page.
+type ViewSeries struct {
+	Title     string
+	URL       string
+	Days      []DailyViews
+	Total     int
+	Referrers []ReferrerCount
+}
+
+// AnalyticsReport is the view history of a developer's profile and projects.
+type AnalyticsReport struct {
+	Profile  ViewSeries
+	Projects []ViewSeries
+}
+
+// AnalyticsUseCase defines the business logic for page view analytics.
+type AnalyticsUseCase struct {
+	AnalyticsRepo AnalyticsRepository
+}
+
+// NewAnalyticsUseCase creates a new AnalyticsUseCase.
+func NewAnalyticsUseCase(analyticsRepo AnalyticsRepository) *AnalyticsUseCase {
+	return &AnalyticsUseCase{
+		AnalyticsRepo: analyticsRepo,
+	}
+}
+
+// RecordView records a view of a profile or project page.
+// Bots and owners viewing their own pages are not counted, and each visitor counts once per day.
+func (uc *AnalyticsUseCase) RecordView(kind string, targetID, ownerID uuid.UUID, visitor Visitor) error {
+	if IsBotUserAgent(visitor.UserAgent) {
+		return nil
+	}
+	if visitor.UserID != uuid.Nil && visitor.UserID == ownerID {
+		return nil
+	}
+
+	day := today()
+	view := &domain.PageView{
+		Kind:        kind,
+		TargetID:    targetID,
+		VisitorHash: visitorHash(visitor, day),
+		Day:         day,
+		Referrer:    referrerHost(visitor.Referrer),
+	}
+	if err := uc.AnalyticsRepo.RecordPageView(view); err != nil {
+		return fmt.Errorf("failed to record page view: %w", err)
+	}
+	return nil
+}
+
+// RollupViews folds raw page views into the daily stats and purges raw views past their retention.
+func (uc *AnalyticsUseCase) RollupViews() error {
+	if err := uc.AnalyticsRepo.RollupPageViews(); err != nil {
+		return fmt.Errorf("failed to roll up page views: %w", err)
+	}
+	if err := uc.AnalyticsRepo.PurgePageViews(today().AddDate(0, 0, -pageViewRetentionDays)); err != nil {
+		return fmt.Errorf("failed to purge page views: %w", err)
+	}
+	return nil
+}
+
+// GetOwnerReport returns the last AnalyticsWindowDays days of views of a profile and its projects.
+func (uc *AnalyticsUseCase) GetOwnerReport(profile *domain.Profile) (*AnalyticsReport, error) {
+	since := today().AddDate(0, 0, -(AnalyticsWindowDays - 1))
+
+	profileSeries, err := uc.buildSeries(domain.ViewKindProfile, []uuid.UUID{profile.ID}, since)
+	if err != nil {
+		return nil, err
+	}
+	report := &AnalyticsReport{Profile: profileSeries[profile.ID]}
+	report.Profile.Title = profile.Name
+	report.Profile.URL = profile.URL()
+
+	if len(profile.Projects) == 0 {
+		return report, nil
+	}
+	projectIDs := make([]uuid.UUID, len(profile.Projects))
+	for i, project := range profile.Projects {
+		projectIDs[i] = project.ID
+	}
+	projectSeries, err := uc.buildSeries(domain.ViewKindProject, projectIDs, since)
+	if err != nil {
+		return nil, err
+	}
+	for _, project := range profile.Projects {
+		series := projectSeries[project.ID]
+		series.Title = project.Title
+		series.URL = project.URL()
+		report.Projects = append(report.Projects, series)
+	}
+	return report, nil
+}
+
+// buildSeries loads the daily views and top referrers of the given pages since a day.
+// Every page gets a series with one entry per day, including days without views.
+func (uc *AnalyticsUseCase) buildSeries(kind string, targetIDs []uuid.UUID, since time.Time) (map[uuid.UUID]ViewSeries, error) {
+	stats, err := uc.AnalyticsRepo.FindViewStats(kind, targetIDs, since)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load view stats: %w", err)
+	}
+	referrers, err := uc.AnalyticsRepo.FindReferrerCounts(kind, targetIDs, since)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load referrers: %w", err)
+	}
+
+	viewsByDay := make(map[uuid.UUID]map[string]int, len(targetIDs))
+	for _, stat := range stats {
+		if viewsByDay[stat.TargetID] == nil {
+			viewsByDay[stat.TargetID] = make(map[string]int)
+		}
+		viewsByDay[stat.TargetID][stat.Day.Format(time.DateOnly)] += stat.Views
+	}
+
+	series := make(map[uuid.UUID]ViewSeries, len(targetIDs))
+	for _, targetID := range targetIDs {
+		var s ViewSeries
+		maxViews := 0
+		for i := 0; i < AnalyticsWindowDays; i++ {
+			day := since.AddDate(0, 0, i)
+			views := viewsByDay[targetID][day.Format(time.DateOnly)]
+			s.Days = append(s.Days, DailyViews{Day: day, Views: views})
+			s.Total += views
+			maxViews = max(maxViews, views)
+		}
+		if maxViews > 0 {
+			for i := range s.Days {
+				s.Days[i].Percent = s.Days[i].Views * 100 / maxViews
+			}
+		}
+		series[targetID] = s
+	}
+
+	// Referrer counts arrive busiest first, so the first ones seen per page are its top referrers
+	for _, referrer := range referrers {
+		s := series[referrer.TargetID]
+		if len(s.Referrers) < topReferrersLimit {
+			s.Referrers = append(s.Referrers, referrer)
+			series[referrer.TargetID] = s
+		}
+	}
+	return series, nil
+}
+
+// IsBotUserAgent reports whether a user agent belongs to a crawler or other automated client.
+// Requests without a user agent are treated as automated.
+func IsBotUserAgent(userAgent string) bool {
+	userAgent = strings.ToLower(strings.TrimSpace(userAgent))
+	if userAgent == "" {
+		return true
+	}
+	for _, marker := range botUserAgentMarkers {
+		if strings.Contains(userAgent, marker) {
+			return true
+		}
+	}
+	return false
+}
+
+// today returns the current UTC day at midnight, the granularity of page view deduplication.
+func today() time.Time {
+	return time.Now().UTC().Truncate(24 * time.Hour)
+}
+
+// visitorHash identifies a visitor for one day without storing their IP address or user ID.
+// The day is part of the hash, so a visitor cannot be followed from one day to the next.
+func visitorHash(visitor Visitor, day time.Time) string {
+	identity := "anon:" + visitor.IP + "|" + visitor.UserAgent
+	if visitor.UserID != uuid.Nil {
+		identity = "user:" + visitor.UserID.String()
+	}
+	sum := sha256.Sum256([]byte(day.Format(time.DateOnly) + "|" + identity))
+	return hex.EncodeToString(sum[:])
+}
+
+// referrerHost reduces a Referer header to the referring site's host name.
+func referrerHost(referrer string) string {
+	parsed, err := url.Parse(strings.TrimSpace(referrer))
+	if err != nil || parsed.Hostname() == "" {
+		return domain.DirectReferrer
+	}
+	host := strings.TrimPrefix(strings.ToLower(parsed.Hostname()), "www.")
+	if len(host) > 255 {
+		host = host[:255]
+	}
+	return host
+}
@@ -0,0 +1,12 @@
+package application
+
+import (
+	"devsearch-go/internal/domain"
+)
+
+// AuditRepository defines the interface for audit log data operations. The audit log is
+// append-only, so events can be created and searched but never changed or deleted.
+type AuditRepository interface {
+	CreateAuditEvent(event *domain.AuditEvent) error
+	FindAuditEvents(filter AuditFilter, page, limit int) ([]domain.AuditEvent, int64, error)
+}
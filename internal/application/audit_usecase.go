@@ -0,0 +1,255 @@
+package application
+
+import (
+	"devsearch-go/internal/domain"
+
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// maxAuditExportRows bounds the number of events in one audit log export.
+const maxAuditExportRows = 10000
+
+// maxAuditValueLength bounds the length of a field value kept in an audit event, in characters.
+const maxAuditValueLength = 500
+
+// auditSkippedFields are never recorded as changes: identifiers and timestamps say nothing
+// about what changed, and password hashes must not be copied anywhere.
+var auditSkippedFields = map[string]bool{"ID": true, "CreatedAt": true, "UpdatedAt": true, "Password": true}
+
+// RequestMeta describes where a request came from, for the audit log.
+type RequestMeta struct {
+	IP        string
+	UserAgent string
+}
+
+// AuditFilter narrows down the audit log. Empty fields do not filter.
+type AuditFilter struct {
+	Actor  string // Username of the actor
+	Action string
+	Target string // ID of the target, or text in its label
+	From   time.Time
+	To     time.Time // Events before the end of this day
+}
+
+// AuditUseCase defines the business logic for reading the audit log. Events are written by
+// the use cases that perform the audited actions.
+type AuditUseCase struct {
+	AuditRepo AuditRepository
+	UserRepo  UserRepository
+}
+
+// NewAuditUseCase creates a new AuditUseCase.
+func NewAuditUseCase(auditRepo AuditRepository, userRepo UserRepository) *AuditUseCase {
+	return &AuditUseCase{
+		AuditRepo: auditRepo,
+		UserRepo:  userRepo,
+	}
+}
+
+// GetAuditEvents retrieves the audit events matching the filter, newest first. Only admins
+// can read the audit log.
+func (uc *AuditUseCase) GetAuditEvents(actorID uuid.UUID, filter AuditFilter, page, limit int) ([]domain.AuditEvent, int64, error) {
+	if err := uc.authorize(actorID); err != nil {
+		return nil, 0, err
+	}
+	return uc.AuditRepo.FindAuditEvents(normalizeAuditFilter(filter), page, limit)
+}
+
+// ExportCSV writes the audit events matching the filter as CSV, newest first, up to
+// maxAuditExportRows of them.
+func (uc *AuditUseCase) ExportCSV(w io.Writer, actorID uuid.UUID, filter AuditFilter) error {
+	if err := uc.authorize(actorID); err != nil {
+		return err
+	}
+	events, _, err := uc.AuditRepo.FindAuditEvents(normalizeAuditFilter(filter), 1, maxAuditExportRows)
+	if err != nil {
+		return err
+	}
+
+	writer := csv.NewWriter(w)
+	if err := writer.Write([]string{"Time", "Actor", "Action", "Target Kind", "Target ID", "Target", "IP", "User Agent", "Changes"}); err != nil {
+		return err
+	}
+	for _, event := range events {
+		targetID := ""
+		if event.TargetID != nil {
+			targetID = event.TargetID.String()
+		}
+		changes := make([]string, 0)
+		for _, change := range event.ChangeList() {
+			changes = append(changes, fmt.Sprintf("%s: %q -> %q", change.Field, change.Before, change.After))
+		}
+		row := []string{
+			event.CreatedAt.UTC().Format(time.RFC3339),
+			event.ActorName,
+			event.Action,
+			event.TargetKind,
+			targetID,
+			event.TargetLabel,
+			event.IP,
+			event.UserAgent,
+			strings.Join(changes, "; "),
+		}
+		for i := range row {
+			row[i] = csvSafe(row[i])
+		}
+		if err := writer.Write(row); err != nil {
+			return err
+		}
+	}
+	writer.Flush()
+	return writer.Error()
+}
+
+// authorize checks that the user may read the audit log.
+func (uc *AuditUseCase) authorize(actorID uuid.UUID) error {
+	principal, err := loadStaffPrincipal(uc.UserRepo, actorID)
+	if err != nil {
+		return err
+	}
+	if !Can(principal, ActionView, &domain.AuditEvent{}) {
+		return ErrPermissionDenied
+	}
+	return nil
+}
+
+// normalizeAuditFilter trims the filter and drops an unknown action.
+func normalizeAuditFilter(filter AuditFilter) AuditFilter {
+	filter.Actor = strings.TrimSpace(filter.Actor)
+	filter.Target = strings.TrimSpace(filter.Target)
+	if !contains(domain.AuditActions, filter.Action) {
+		filter.Action = ""
+	}
+	return filter
+}
+
+// recordAudit appends an event to the audit log with where the request came from. Like the
+// activity log it never fails the audited action, but a lost event is logged.
+func recordAudit(auditRepo AuditRepository, meta RequestMeta, event *domain.AuditEvent) {
+	event.IP = meta.IP
+	event.UserAgent = truncateAuditValue(meta.UserAgent, 255)
+	event.TargetLabel = truncateAuditValue(event.TargetLabel, 255)
+	if err := auditRepo.CreateAuditEvent(event); err != nil {
+		log.Printf("Failed to record audit event %s: %v", event.Action, err)
+	}
+}
+
+// auditActor returns the user ID to record as the actor of an audit event.
+func auditActor(userID uuid.UUID) *uuid.UUID {
+	if userID == uuid.Nil {
+		return nil
+	}
+	return &userID
+}
+
+// auditChanges compares the scalar fields of two values of the same struct type and encodes
+// the ones that differ for AuditEvent.Changes. Either value may be nil, for created and
+// deleted resources. Relations, identifiers, timestamps and password hashes are left out.
+func auditChanges(before, after interface{}) string {
+	beforeValues, fields := auditFields(before)
+	afterValues, afterFields := auditFields(after)
+	// A created or deleted resource only lists the fields it has a value for.
+	onlyValues := fields == nil || afterFields == nil
+	if fields == nil {
+		fields = afterFields
+	}
+
+	var changes []domain.AuditChange
+	for _, field := range fields {
+		value := beforeValues[field] + afterValues[field]
+		if onlyValues && (value == "" || value == "0" || value == "false") {
+			continue
+		}
+		if beforeValues[field] != afterValues[field] {
+			changes = append(changes, domain.AuditChange{Field: field, Before: beforeValues[field], After: afterValues[field]})
+		}
+	}
+	if len(changes) == 0 {
+		return ""
+	}
+	encoded, err := json.Marshal(changes)
+	if err != nil {
+		return ""
+	}
+	return string(encoded)
+}
+
+var (
+	timeType = reflect.TypeOf(time.Time{})
+	uuidType = reflect.TypeOf(uuid.UUID{})
+)
+
+// auditFields formats the scalar fields of a struct, or a pointer to one, by name. It returns
+// the field names in declaration order, and nothing for nil.
+func auditFields(value interface{}) (map[string]string, []string) {
+	v := reflect.ValueOf(value)
+	if !v.IsValid() || (v.Kind() == reflect.Ptr && v.IsNil()) {
+		return map[string]string{}, nil
+	}
+	v = reflect.Indirect(v)
+
+	values := make(map[string]string)
+	var fields []string
+	for i := 0; i < v.NumField(); i++ {
+		field := v.Type().Field(i)
+		if !field.IsExported() || auditSkippedFields[field.Name] {
+			continue
+		}
+		formatted, ok := auditValue(v.Field(i))
+		if !ok {
+			continue
+		}
+		values[field.Name] = truncateAuditValue(formatted, maxAuditValueLength)
+		fields = append(fields, field.Name)
+	}
+	return values, fields
+}
+
+// auditValue formats a scalar field value for the audit log. ok is false for relations and
+// other values that are not recorded.
+func auditValue(v reflect.Value) (formatted string, ok bool) {
+	if v.Kind() == reflect.Ptr {
+		if v.Type().Elem() != timeType && v.Type().Elem() != uuidType {
+			return "", false
+		}
+		if v.IsNil() {
+			return "", true
+		}
+		v = v.Elem()
+	}
+	switch {
+	case v.Type() == timeType:
+		if t := v.Interface().(time.Time); !t.IsZero() {
+			return t.UTC().Format(time.RFC3339), true
+		}
+		return "", true
+	case v.Type() == uuidType:
+		if id := v.Interface().(uuid.UUID); id != uuid.Nil {
+			return id.String(), true
+		}
+		return "", true
+	}
+	switch v.Kind() {
+	case reflect.String, reflect.Bool, reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Float32, reflect.Float64:
+		return fmt.Sprint(v.Interface()), true
+	}
+	return "", false
+}
+
+// truncateAuditValue trims text to at most max characters.
+func truncateAuditValue(text string, max int) string {
+	if runes := []rune(text); len(runes) > max {
+		return string(runes[:max-1]) + "…"
+	}
+	return text
+}
@@ -0,0 +1,252 @@
+package application
+
+import (
+	"devsearch-go/internal/domain"
+
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+// Action is something a principal wants to do with a resource.
+type Action string
+
+// Actions checked by Can.
+const (
+	// ActionView covers reading resources that are not public, such as messages and shortlists.
+	ActionView Action = "view"
+	// ActionCreate covers adding a resource to one owned by someone else, such as a collaborator to a project.
+	ActionCreate Action = "create"
+	// ActionEdit covers changing a resource.
+	ActionEdit Action = "edit"
+	// ActionDelete covers deleting a resource, or leaving it for collaborators.
+	ActionDelete Action = "delete"
+	// ActionManageCollaborators covers inviting and removing the collaborators of a project.
+	ActionManageCollaborators Action = "manage_collaborators"
+	// ActionRespond covers accepting or declining an invitation.
+	ActionRespond Action = "respond"
+	// ActionHide covers taking a project out of public listings.
+	ActionHide Action = "hide"
+	// ActionSuspend covers suspending and reinstating user accounts.
+	ActionSuspend Action = "suspend"
+	// ActionManageRoles covers making users moderators or admins.
+	ActionManageRoles Action = "manage_roles"
+	// ActionResolve covers acting on or dismissing the reports in the moderation queue.
+	ActionResolve Action = "resolve"
+	// ActionRevert covers restoring an earlier revision of a project.
+	ActionRevert Action = "revert"
+)
+
+// ProjectRoleOwner is the role ProjectRole reports for the owner of a project.
+const ProjectRoleOwner = "owner"
+
+// Relations between a principal and a resource, the rows of the permissions table.
+const (
+	relationOwner       = "owner"
+	relationMaintainer  = domain.CollaboratorRoleMaintainer
+	relationContributor = domain.CollaboratorRoleContributor
+	relationRecipient   = "recipient"
+	relationInvitee     = "invitee"
+	relationManager     = "manager"
+)
+
+// permissions lists, per kind of resource and relation to it, the actions a principal may take.
+// Staff roles are relations too, granted on top of the principal's own relation to the
+// resource. Anything not listed is denied.
+var permissions = map[string]map[string][]Action{
+	"project": {
+		relationOwner:            {ActionView, ActionEdit, ActionDelete, ActionManageCollaborators, ActionRevert},
+		relationMaintainer:       {ActionView, ActionEdit, ActionManageCollaborators},
+		relationContributor:      {ActionView},
+		domain.UserRoleModerator: {ActionView, ActionHide},
+		domain.UserRoleAdmin:     {ActionView, ActionHide},
+	},
+	"user": {
+		domain.UserRoleModerator: {ActionView, ActionSuspend},
+		domain.UserRoleAdmin:     {ActionView, ActionSuspend, ActionManageRoles},
+	},
+	"review": {
+		domain.UserRoleModerator: {ActionDelete},
+		domain.UserRoleAdmin:     {ActionDelete},
+	},
+	"report_case": {
+		domain.UserRoleModerator: {ActionView, ActionResolve},
+		domain.UserRoleAdmin:     {ActionView, ActionResolve},
+	},
+	"audit_event": {
+		domain.UserRoleAdmin: {ActionView},
+	},
+	"collaborator": {
+		relationInvitee: {ActionView, ActionRespond, ActionDelete},
+		relationManager: {ActionView, ActionCreate, ActionDelete},
+	},
+	"skill":        {relationOwner: {ActionView, ActionEdit, ActionDelete}},
+	"experience":   {relationOwner: {ActionView, ActionEdit, ActionDelete}},
+	"education":    {relationOwner: {ActionView, ActionEdit, ActionDelete}},
+	"job_posting":  {relationOwner: {ActionView, ActionEdit, ActionDelete}},
+	"shortlist":    {relationOwner: {ActionView, ActionEdit, ActionDelete}},
+	"saved_search": {relationOwner: {ActionView, ActionEdit, ActionDelete}},
+	"message":      {relationRecipient: {ActionView, ActionEdit, ActionDelete}},
+}
+
+var (
+	// ErrProjectNotFound is returned for projects that do not exist.
+	ErrProjectNotFound = errors.New("project not found")
+	// ErrPermissionDenied is returned when the principal may not perform an action on a resource.
+	ErrPermissionDenied = errors.New("you don't have permission to do this")
+)
+
+// Principal is the signed-in user an authorisation decision is made for. The zero value is
+// an anonymous visitor, who may not change anything. Role is only needed for staff actions.
+type Principal struct {
+	UserID    uuid.UUID
+	ProfileID uuid.UUID
+	Role      string
+}
+
+// Can reports whether the principal may perform the action on the resource, which is a
+// pointer to a domain entity. Projects need their collaborators and their profiles loaded, and
+// collaborators need their profile and their project with its collaborators.
+func Can(principal Principal, action Action, resource interface{}) bool {
+	if principal.UserID == uuid.Nil {
+		return false
+	}
+	kind, relation := relationTo(principal, resource)
+	return allows(permissions[kind][relation], action) || allows(permissions[kind][staffRelation(principal, resource)], action)
+}
+
+// allows reports whether the action is one of the allowed actions.
+func allows(allowed []Action, action Action) bool {
+	for _, candidate := range allowed {
+		if candidate == action {
+			return true
+		}
+	}
+	return false
+}
+
+// staffRelation returns the principal's staff role when it applies to the resource. Staff
+// cannot moderate their own account, and only admins moderate other staff.
+func staffRelation(principal Principal, resource interface{}) string {
+	if !domain.IsStaffRole(principal.Role) {
+		return ""
+	}
+	if user, ok := resource.(*domain.User); ok {
+		if user.ID == principal.UserID || (user.IsStaff() && principal.Role != domain.UserRoleAdmin) {
+			return ""
+		}
+	}
+	return principal.Role
+}
+
+// CanSeeProject reports whether the principal may open the page of a project. Unpublished and
+// hidden projects are only shown to their owner, their collaborators and staff.
+func CanSeeProject(principal Principal, project *domain.Project) bool {
+	return (project.IsPublished() && !project.IsHidden()) || Can(principal, ActionView, project)
+}
+
+// relationTo returns the kind of a resource and how the principal relates to it.
+func relationTo(principal Principal, resource interface{}) (kind, relation string) {
+	switch r := resource.(type) {
+	case *domain.Project:
+		return "project", ProjectRole(r, principal.UserID)
+	case *domain.ProjectCollaborator:
+		if r.Profile.UserID == principal.UserID {
+			return "collaborator", relationInvitee
+		}
+		// Maintainers manage contributors; only the owner manages maintainers.
+		switch ProjectRole(&r.Project, principal.UserID) {
+		case ProjectRoleOwner:
+			return "collaborator", relationManager
+		case domain.CollaboratorRoleMaintainer:
+			if r.Role != domain.CollaboratorRoleMaintainer {
+				return "collaborator", relationManager
+			}
+		}
+		return "collaborator", ""
+	case *domain.Skill:
+		return "skill", ownedBy(principal, r.OwnerID)
+	case *domain.Experience:
+		return "experience", ownedBy(principal, r.OwnerID)
+	case *domain.Education:
+		return "education", ownedBy(principal, r.OwnerID)
+	case *domain.JobPosting:
+		return "job_posting", ownedBy(principal, r.OwnerID)
+	case *domain.Shortlist:
+		return "shortlist", ownedBy(principal, r.OwnerID)
+	case *domain.SavedSearch:
+		return "saved_search", ownedBy(principal, r.OwnerID)
+	case *domain.User:
+		return "user", ""
+	case *domain.Review:
+		return "review", ""
+	case *domain.ReportCase:
+		return "report_case", ""
+	case *domain.AuditEvent:
+		return "audit_event", ""
+	case *domain.Message:
+		if principal.ProfileID != uuid.Nil && r.RecipientID == principal.ProfileID {
+			return "message", relationRecipient
+		}
+		return "message", ""
+	}
+	return "", ""
+}
+
+// ownedBy returns relationOwner when the profile-owned resource belongs to the principal.
+func ownedBy(principal Principal, ownerProfileID uuid.UUID) string {
+	if principal.ProfileID != uuid.Nil && ownerProfileID == principal.ProfileID {
+		return relationOwner
+	}
+	return ""
+}
+
+// ProjectRole returns the user's role on a project: ProjectRoleOwner, the role of an accepted
+// collaborator, or "" for everyone else. The project needs its collaborators and their profiles loaded.
+func ProjectRole(project *domain.Project, userID uuid.UUID) string {
+	if userID == uuid.Nil {
+		return ""
+	}
+	if project.OwnerID == userID {
+		return ProjectRoleOwner
+	}
+	for _, collaborator := range project.Collaborators {
+		if collaborator.Profile.UserID == userID && collaborator.IsAccepted() {
+			return collaborator.Role
+		}
+	}
+	return ""
+}
+
+// loadPrincipal builds the principal for a signed-in user from their profile.
+func loadPrincipal(profileRepo ProfileRepository, userID uuid.UUID) (Principal, error) {
+	profile, err := profileRepo.FindProfileByUserID(userID)
+	if err != nil {
+		return Principal{}, fmt.Errorf("profile not found for user: %w", err)
+	}
+	return Principal{UserID: userID, ProfileID: profile.ID}, nil
+}
+
+// loadStaffPrincipal builds the principal for a user with their role, failing with
+// ErrPermissionDenied unless they are an active moderator or admin.
+func loadStaffPrincipal(userRepo UserRepository, userID uuid.UUID) (Principal, error) {
+	user, err := userRepo.FindUserByID(userID)
+	if err != nil || !user.IsStaff() || user.IsSuspended() {
+		return Principal{}, ErrPermissionDenied
+	}
+	return Principal{UserID: user.ID, Role: user.Role}, nil
+}
+
+// authorizeProject loads a project and checks that the user may perform the action on it.
+// Project roles are tied to users rather than profiles, so no profile lookup is needed.
+func authorizeProject(projectRepo ProjectRepository, projectID, userID uuid.UUID, action Action) (*domain.Project, error) {
+	project, err := projectRepo.FindProjectByID(projectID)
+	if err != nil {
+		return nil, ErrProjectNotFound
+	}
+	if !Can(Principal{UserID: userID}, action, project) {
+		return project, ErrPermissionDenied
+	}
+	return project, nil
+}
@@ -0,0 +1,213 @@
+package application
+
+import (
+	"devsearch-go/internal/domain"
+
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// allActions lists every action Can knows about, so each row of the matrix checks all of them.
+var allActions = []Action{
+	ActionView, ActionCreate, ActionEdit, ActionDelete, ActionManageCollaborators, ActionRespond,
+	ActionHide, ActionSuspend, ActionManageRoles, ActionResolve, ActionRevert,
+}
+
+// permissionFixture holds the principals and resources the permission matrix is checked against.
+type permissionFixture struct {
+	principals map[string]Principal
+	resources  map[string]interface{}
+	projects   map[string]*domain.Project
+}
+
+// newPermissionFixture builds a project owned by "owner" with an accepted maintainer, an
+// accepted contributor and a pending invitation, along with the other resources of the matrix.
+func newPermissionFixture() permissionFixture {
+	newProfile := func() domain.Profile {
+		return domain.Profile{ID: uuid.New(), UserID: uuid.New()}
+	}
+	owner, maintainer, contributor, invitee, stranger := newProfile(), newProfile(), newProfile(), newProfile(), newProfile()
+	moderator, admin := newProfile(), newProfile()
+	accepted := time.Now()
+
+	collaborators := []domain.ProjectCollaborator{
+		{ID: uuid.New(), Profile: maintainer, ProfileID: maintainer.ID, Role: domain.CollaboratorRoleMaintainer, AcceptedAt: &accepted},
+		{ID: uuid.New(), Profile: contributor, ProfileID: contributor.ID, Role: domain.CollaboratorRoleContributor, AcceptedAt: &accepted},
+		{ID: uuid.New(), Profile: invitee, ProfileID: invitee.ID, Role: domain.CollaboratorRoleContributor},
+	}
+	newProject := func(status string, hidden bool) *domain.Project {
+		project := &domain.Project{ID: uuid.New(), OwnerID: owner.UserID, Title: "Project", Status: status}
+		if hidden {
+			project.HiddenAt = &accepted
+		}
+		for _, collaborator := range collaborators {
+			collaborator.ProjectID = project.ID
+			project.Collaborators = append(project.Collaborators, collaborator)
+		}
+		return project
+	}
+	project := newProject(domain.ProjectStatusPublished, false)
+	collaboratorOf := func(i int) *domain.ProjectCollaborator {
+		collaborator := project.Collaborators[i]
+		collaborator.Project = *project
+		return &collaborator
+	}
+
+	return permissionFixture{
+		principals: map[string]Principal{
+			"anonymous":   {},
+			"stranger":    {UserID: stranger.UserID, ProfileID: stranger.ID, Role: domain.UserRoleUser},
+			"owner":       {UserID: owner.UserID, ProfileID: owner.ID, Role: domain.UserRoleUser},
+			"maintainer":  {UserID: maintainer.UserID, ProfileID: maintainer.ID, Role: domain.UserRoleUser},
+			"contributor": {UserID: contributor.UserID, ProfileID: contributor.ID, Role: domain.UserRoleUser},
+			"invitee":     {UserID: invitee.UserID, ProfileID: invitee.ID, Role: domain.UserRoleUser},
+			"moderator":   {UserID: moderator.UserID, ProfileID: moderator.ID, Role: domain.UserRoleModerator},
+			"admin":       {UserID: admin.UserID, ProfileID: admin.ID, Role: domain.UserRoleAdmin},
+		},
+		resources: map[string]interface{}{
+			"project":                  project,
+			"profile skill":            &domain.Skill{ID: uuid.New(), OwnerID: owner.ID, Name: "Go"},
+			"profile experience":       &domain.Experience{ID: uuid.New(), OwnerID: owner.ID},
+			"maintainer collaborator":  collaboratorOf(0),
+			"contributor collaborator": collaboratorOf(1),
+			"pending collaborator":     collaboratorOf(2),
+			"review":                   &domain.Review{ID: uuid.New(), ProjectID: project.ID, OwnerID: stranger.UserID},
+			"report":                   &domain.ReportCase{ID: uuid.New(), TargetKind: domain.ReportTargetProject, TargetID: project.ID},
+			"regular user":             &domain.User{ID: stranger.UserID, Role: domain.UserRoleUser},
+			"moderator user":           &domain.User{ID: uuid.New(), Role: domain.UserRoleModerator},
+			"admin user":               &domain.User{ID: uuid.New(), Role: domain.UserRoleAdmin},
+		},
+		projects: map[string]*domain.Project{
+			"draft":     newProject(domain.ProjectStatusDraft, false),
+			"hidden":    newProject(domain.ProjectStatusPublished, true),
+			"published": project,
+		},
+	}
+}
+
+func TestPermissionMatrix(t *testing.T) {
+	var (
+		projectOwner   = []Action{ActionView, ActionEdit, ActionDelete, ActionManageCollaborators, ActionRevert}
+		profileOwner   = []Action{ActionView, ActionEdit, ActionDelete}
+		manager        = []Action{ActionView, ActionCreate, ActionDelete}
+		invited        = []Action{ActionView, ActionRespond, ActionDelete}
+		projectStaff   = []Action{ActionView, ActionHide}
+		moderateUser   = []Action{ActionView, ActionSuspend}
+		administerUser = []Action{ActionView, ActionSuspend, ActionManageRoles}
+		handleReport   = []Action{ActionView, ActionResolve}
+		deleteReview   = []Action{ActionDelete}
+		everyProject   = map[string]bool{"draft": true, "hidden": true, "published": true}
+		publicProjects = map[string]bool{"published": true}
+	)
+
+	// Each row lists, per resource, the actions the principal may take; every other action
+	// on every resource, including "own account", must be denied. canSee lists the projects
+	// CanSeeProject lets the principal open.
+	tests := []struct {
+		principal string
+		allowed   map[string][]Action
+		canSee    map[string]bool
+	}{
+		{principal: "anonymous", canSee: publicProjects},
+		{principal: "stranger", canSee: publicProjects},
+		{
+			principal: "owner",
+			allowed: map[string][]Action{
+				"project":                  projectOwner,
+				"profile skill":            profileOwner,
+				"profile experience":       profileOwner,
+				"maintainer collaborator":  manager,
+				"contributor collaborator": manager,
+				"pending collaborator":     manager,
+			},
+			canSee: everyProject,
+		},
+		{
+			principal: "maintainer",
+			allowed: map[string][]Action{
+				"project":                  {ActionView, ActionEdit, ActionManageCollaborators},
+				"maintainer collaborator":  invited,
+				"contributor collaborator": manager,
+				"pending collaborator":     manager,
+			},
+			canSee: everyProject,
+		},
+		{
+			principal: "contributor",
+			allowed: map[string][]Action{
+				"project":                  {ActionView},
+				"contributor collaborator": invited,
+			},
+			canSee: everyProject,
+		},
+		{
+			principal: "invitee",
+			allowed:   map[string][]Action{"pending collaborator": invited},
+			canSee:    publicProjects,
+		},
+		{
+			principal: "moderator",
+			allowed: map[string][]Action{
+				"project":      projectStaff,
+				"review":       deleteReview,
+				"report":       handleReport,
+				"regular user": moderateUser,
+			},
+			canSee: everyProject,
+		},
+		{
+			principal: "admin",
+			allowed: map[string][]Action{
+				"project":        projectStaff,
+				"review":         deleteReview,
+				"report":         handleReport,
+				"regular user":   administerUser,
+				"moderator user": administerUser,
+				"admin user":     administerUser,
+			},
+			canSee: everyProject,
+		},
+	}
+
+	fixture := newPermissionFixture()
+	for _, tt := range tests {
+		t.Run(tt.principal, func(t *testing.T) {
+			principal := fixture.principals[tt.principal]
+			resources := map[string]interface{}{"own account": &domain.User{ID: principal.UserID, Role: principal.Role}}
+			for name, resource := range fixture.resources {
+				resources[name] = resource
+			}
+			for name := range tt.allowed {
+				if _, ok := resources[name]; !ok {
+					t.Fatalf("unknown resource %q", name)
+				}
+			}
+
+			for name, resource := range resources {
+				for _, action := range allActions {
+					want := hasAction(tt.allowed[name], action)
+					if got := Can(principal, action, resource); got != want {
+						t.Errorf("Can(%s, %s, %s) = %v, want %v", tt.principal, action, name, got, want)
+					}
+				}
+			}
+			for name, project := range fixture.projects {
+				if got := CanSeeProject(principal, project); got != tt.canSee[name] {
+					t.Errorf("CanSeeProject(%s, %s project) = %v, want %v", tt.principal, name, got, tt.canSee[name])
+				}
+			}
+		})
+	}
+}
+
+// hasAction reports whether the action is one of the actions.
+func hasAction(actions []Action, action Action) bool {
+	for _, candidate := range actions {
+		if candidate == action {
+			return true
+		}
+	}
+	return false
+}
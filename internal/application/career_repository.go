@@ -0,0 +1,23 @@
+package application
+
+import (
+	"devsearch-go/internal/domain"
+
+	"github.com/google/uuid"
+)
+
+// ExperienceRepository defines the interface for work experience data operations.
+type ExperienceRepository interface {
+	CreateExperience(experience *domain.Experience) error
+	FindExperienceByID(id uuid.UUID) (*domain.Experience, error)
+	UpdateExperience(experience *domain.Experience) error
+	DeleteExperience(id uuid.UUID) error
+}
+
+// EducationRepository defines the interface for education data operations.
+type EducationRepository interface {
+	CreateEducation(education *domain.Education) error
+	FindEducationByID(id uuid.UUID) (*domain.Education, error)
+	UpdateEducation(education *domain.Education) error
+	DeleteEducation(id uuid.UUID) error
+}
@@ -0,0 +1,201 @@
+package application
+
+import (
+	"devsearch-go/internal/domain"
+
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+var (
+	// ErrExperienceNotFound is returned for experience entries that do not exist or belong to another developer.
+	ErrExperienceNotFound = errors.New("experience not found")
+	// ErrEducationNotFound is returned for education entries that do not exist or belong to another developer.
+	ErrEducationNotFound = errors.New("education not found")
+)
+
+// CareerUseCase defines the business logic for work experience and education entries.
+type CareerUseCase struct {
+	ProfileRepo    ProfileRepository
+	ExperienceRepo ExperienceRepository
+	EducationRepo  EducationRepository
+}
+
+// NewCareerUseCase creates a new CareerUseCase.
+func NewCareerUseCase(profileRepo ProfileRepository, experienceRepo ExperienceRepository, educationRepo EducationRepository) *CareerUseCase {
+	return &CareerUseCase{
+		ProfileRepo:    profileRepo,
+		ExperienceRepo: experienceRepo,
+		EducationRepo:  educationRepo,
+	}
+}
+
+// CreateExperience adds a work experience entry to the user's profile.
+func (uc *CareerUseCase) CreateExperience(userID uuid.UUID, experience *domain.Experience) error {
+	if err := validateExperience(experience); err != nil {
+		return err
+	}
+
+	profile, err := uc.ProfileRepo.FindProfileByUserID(userID)
+	if err != nil {
+		return fmt.Errorf("profile not found for user: %w", err)
+	}
+
+	experience.OwnerID = profile.ID
+	if err := uc.ExperienceRepo.CreateExperience(experience); err != nil {
+		return fmt.Errorf("failed to create experience: %w", err)
+	}
+	return nil
+}
+
+// UpdateExperience updates a work experience entry owned by the user.
+func (uc *CareerUseCase) UpdateExperience(experienceID, userID uuid.UUID, data *domain.Experience) (*domain.Experience, error) {
+	if err := validateExperience(data); err != nil {
+		return nil, err
+	}
+
+	experience, err := uc.loadOwnExperience(userID, experienceID, ActionEdit)
+	if err != nil {
+		return nil, err
+	}
+
+	experience.Company = data.Company
+	experience.Title = data.Title
+	experience.Location = data.Location
+	experience.StartDate = data.StartDate
+	experience.EndDate = data.EndDate
+	experience.Description = data.Description
+	experience.Technologies = data.Technologies
+
+	if err := uc.ExperienceRepo.UpdateExperience(experience); err != nil {
+		return nil, fmt.Errorf("failed to update experience: %w", err)
+	}
+	return experience, nil
+}
+
+// DeleteExperience deletes a work experience entry owned by the user.
+func (uc *CareerUseCase) DeleteExperience(experienceID, userID uuid.UUID) error {
+	experience, err := uc.loadOwnExperience(userID, experienceID, ActionDelete)
+	if err != nil {
+		return err
+	}
+	return uc.ExperienceRepo.DeleteExperience(experience.ID)
+}
+
+// GetUserExperience retrieves a work experience entry owned by the user.
+func (uc *CareerUseCase) GetUserExperience(experienceID, userID uuid.UUID) (*domain.Experience, error) {
+	return uc.loadOwnExperience(userID, experienceID, ActionView)
+}
+
+// CreateEducation adds an education entry to the user's profile.
+func (uc *CareerUseCase) CreateEducation(userID uuid.UUID, education *domain.Education) error {
+	if err := validateEducation(education); err != nil {
+		return err
+	}
+
+	profile, err := uc.ProfileRepo.FindProfileByUserID(userID)
+	if err != nil {
+		return fmt.Errorf("profile not found for user: %w", err)
+	}
+
+	education.OwnerID = profile.ID
+	if err := uc.EducationRepo.CreateEducation(education); err != nil {
+		return fmt.Errorf("failed to create education: %w", err)
+	}
+	return nil
+}
+
+// UpdateEducation updates an education entry owned by the user.
+func (uc *CareerUseCase) UpdateEducation(educationID, userID uuid.UUID, data *domain.Education) (*domain.Education, error) {
+	if err := validateEducation(data); err != nil {
+		return nil, err
+	}
+
+	education, err := uc.loadOwnEducation(userID, educationID, ActionEdit)
+	if err != nil {
+		return nil, err
+	}
+
+	education.School = data.School
+	education.Degree = data.Degree
+	education.FieldOfStudy = data.FieldOfStudy
+	education.StartDate = data.StartDate
+	education.EndDate = data.EndDate
+	education.Description = data.Description
+
+	if err := uc.EducationRepo.UpdateEducation(education); err != nil {
+		return nil, fmt.Errorf("failed to update education: %w", err)
+	}
+	return education, nil
+}
+
+// DeleteEducation deletes an education entry owned by the user.
+func (uc *CareerUseCase) DeleteEducation(educationID, userID uuid.UUID) error {
+	education, err := uc.loadOwnEducation(userID, educationID, ActionDelete)
+	if err != nil {
+		return err
+	}
+	return uc.EducationRepo.DeleteEducation(education.ID)
+}
+
+// GetUserEducation retrieves an education entry owned by the user.
+func (uc *CareerUseCase) GetUserEducation(educationID, userID uuid.UUID) (*domain.Education, error) {
+	return uc.loadOwnEducation(userID, educationID, ActionView)
+}
+
+func validateExperience(experience *domain.Experience) error {
+	if strings.TrimSpace(experience.Company) == "" || strings.TrimSpace(experience.Title) == "" {
+		return fmt.Errorf("company and title are required")
+	}
+	if experience.StartDate.IsZero() {
+		return fmt.Errorf("start date is required")
+	}
+	if experience.EndDate != nil && experience.EndDate.Before(experience.StartDate) {
+		return fmt.Errorf("end date must not be before start date")
+	}
+	return nil
+}
+
+func validateEducation(education *domain.Education) error {
+	if strings.TrimSpace(education.School) == "" {
+		return fmt.Errorf("school is required")
+	}
+	if education.StartDate.IsZero() {
+		return fmt.Errorf("start date is required")
+	}
+	if education.EndDate != nil && education.EndDate.Before(education.StartDate) {
+		return fmt.Errorf("end date must not be before start date")
+	}
+	return nil
+}
+
+// loadOwnExperience loads an experience entry the user may perform the action on, treating
+// entries of other developers as missing.
+func (uc *CareerUseCase) loadOwnExperience(userID, experienceID uuid.UUID, action Action) (*domain.Experience, error) {
+	principal, err := loadPrincipal(uc.ProfileRepo, userID)
+	if err != nil {
+		return nil, err
+	}
+	experience, err := uc.ExperienceRepo.FindExperienceByID(experienceID)
+	if err != nil || !Can(principal, action, experience) {
+		return nil, ErrExperienceNotFound
+	}
+	return experience, nil
+}
+
+// loadOwnEducation loads an education entry the user may perform the action on, treating
+// entries of other developers as missing.
+func (uc *CareerUseCase) loadOwnEducation(userID, educationID uuid.UUID, action Action) (*domain.Education, error) {
+	principal, err := loadPrincipal(uc.ProfileRepo, userID)
+	if err != nil {
+		return nil, err
+	}
+	education, err := uc.EducationRepo.FindEducationByID(educationID)
+	if err != nil || !Can(principal, action, education) {
+		return nil, ErrEducationNotFound
+	}
+	return education, nil
+}
@@ -0,0 +1,17 @@
+package application
+
+import (
+	"devsearch-go/internal/domain"
+
+	"github.com/google/uuid"
+)
+
+// CollaboratorRepository defines the interface for data operations on project collaborators and invitations.
+type CollaboratorRepository interface {
+	FindCollaboratorByID(id uuid.UUID) (*domain.ProjectCollaborator, error)
+	FindCollaborator(projectID, profileID uuid.UUID) (*domain.ProjectCollaborator, error)
+	FindPendingInvitations(profileID uuid.UUID) ([]domain.ProjectCollaborator, error)
+	CreateCollaborator(collaborator *domain.ProjectCollaborator) error
+	UpdateCollaborator(collaborator *domain.ProjectCollaborator) error
+	DeleteCollaborator(id uuid.UUID) error
+}
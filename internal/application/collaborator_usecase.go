@@ -0,0 +1,151 @@
+package application
+
+import (
+	"devsearch-go/internal/domain"
+
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	// ErrInvalidCollaboratorRole is returned for a role outside domain.CollaboratorRoles.
+	ErrInvalidCollaboratorRole = errors.New("collaborator role must be maintainer or contributor")
+	// ErrCollaboratorNotFound is returned for collaborators and invitations that do not exist or
+	// that the user may not act on.
+	ErrCollaboratorNotFound = errors.New("collaborator not found")
+	// ErrInviteeNotFound is returned when no developer has the invited username.
+	ErrInviteeNotFound = errors.New("no developer with that username")
+	// ErrInviteOwner is returned when the owner of a project is invited to it.
+	ErrInviteOwner = errors.New("the owner of a project cannot be invited to it")
+	// ErrAlreadyCollaborator is returned when the developer is already invited to or working on the project.
+	ErrAlreadyCollaborator = errors.New("this developer is already invited to the project")
+	// ErrOwnerOnlyMaintainers is returned when someone other than the owner invites a maintainer.
+	ErrOwnerOnlyMaintainers = errors.New("only the owner can invite maintainers")
+)
+
+// CollaboratorUseCase defines the business logic for project collaborators and their invitations.
+type CollaboratorUseCase struct {
+	ProjectRepo      ProjectRepository
+	CollaboratorRepo CollaboratorRepository
+	ProfileRepo      ProfileRepository
+	MessageRepo      MessageRepository
+}
+
+// NewCollaboratorUseCase creates a new CollaboratorUseCase.
+func NewCollaboratorUseCase(projectRepo ProjectRepository, collaboratorRepo CollaboratorRepository, profileRepo ProfileRepository, messageRepo MessageRepository) *CollaboratorUseCase {
+	return &CollaboratorUseCase{
+		ProjectRepo:      projectRepo,
+		CollaboratorRepo: collaboratorRepo,
+		ProfileRepo:      profileRepo,
+		MessageRepo:      messageRepo,
+	}
+}
+
+// InviteCollaborator invites the developer with the given username to a project the user may
+// manage, and lets them know through their inbox. Only the owner can invite maintainers.
+func (uc *CollaboratorUseCase) InviteCollaborator(userID, projectID uuid.UUID, username, role string) (*domain.ProjectCollaborator, error) {
+	if !contains(domain.CollaboratorRoles, role) {
+		return nil, ErrInvalidCollaboratorRole
+	}
+	project, err := authorizeProject(uc.ProjectRepo, projectID, userID, ActionManageCollaborators)
+	if err != nil {
+		return nil, err
+	}
+	collaborator := domain.ProjectCollaborator{Project: *project, ProjectID: project.ID, Role: role, InvitedByID: userID}
+	if !Can(Principal{UserID: userID}, ActionCreate, &collaborator) {
+		return nil, ErrOwnerOnlyMaintainers
+	}
+
+	invitee, err := uc.ProfileRepo.FindProfileByUsername(strings.TrimSpace(username))
+	if err != nil {
+		return nil, ErrInviteeNotFound
+	}
+	if invitee.UserID == project.OwnerID {
+		return nil, ErrInviteOwner
+	}
+	if _, err := uc.CollaboratorRepo.FindCollaborator(project.ID, invitee.ID); err == nil {
+		return nil, ErrAlreadyCollaborator
+	}
+
+	collaborator.ProfileID = invitee.ID
+	if err := uc.CollaboratorRepo.CreateCollaborator(&collaborator); err != nil {
+		return nil, fmt.Errorf("failed to invite collaborator: %w", err)
+	}
+
+	// The invitation is listed on the invitee's account page either way, so the message is best-effort.
+	uc.MessageRepo.CreateMessage(&domain.Message{
+		RecipientID: invitee.ID,
+		Name:        "DevSearch",
+		Subject:     fmt.Sprintf("You were invited to \"%s\"", project.Title),
+		Body: fmt.Sprintf("You were invited to join the project \"%s\" as a %s.\n\nAccept or decline the invitation on your account page at /account",
+			project.Title, role),
+	})
+	return &collaborator, nil
+}
+
+// GetPendingInvitations retrieves the invitations the user has not answered yet, with their projects.
+func (uc *CollaboratorUseCase) GetPendingInvitations(userID uuid.UUID) ([]domain.ProjectCollaborator, error) {
+	profile, err := uc.ProfileRepo.FindProfileByUserID(userID)
+	if err != nil {
+		return nil, fmt.Errorf("profile not found for user: %w", err)
+	}
+	return uc.CollaboratorRepo.FindPendingInvitations(profile.ID)
+}
+
+// AcceptInvitation makes the user a collaborator of the project they were invited to.
+func (uc *CollaboratorUseCase) AcceptInvitation(userID, invitationID uuid.UUID) (*domain.ProjectCollaborator, error) {
+	invitation, err := uc.loadOwnInvitation(userID, invitationID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	invitation.AcceptedAt = &now
+	if err := uc.CollaboratorRepo.UpdateCollaborator(invitation); err != nil {
+		return nil, fmt.Errorf("failed to accept invitation: %w", err)
+	}
+	return invitation, nil
+}
+
+// DeclineInvitation deletes an invitation the user received.
+func (uc *CollaboratorUseCase) DeclineInvitation(userID, invitationID uuid.UUID) error {
+	invitation, err := uc.loadOwnInvitation(userID, invitationID)
+	if err != nil {
+		return err
+	}
+	if err := uc.CollaboratorRepo.DeleteCollaborator(invitation.ID); err != nil {
+		return fmt.Errorf("failed to decline invitation: %w", err)
+	}
+	return nil
+}
+
+// RemoveCollaborator takes a collaborator or a pending invitation off a project. Collaborators
+// may always leave, maintainers may remove contributors and the owner may remove anyone. It
+// returns the removed collaborator so callers can link back to its project.
+func (uc *CollaboratorUseCase) RemoveCollaborator(userID, collaboratorID uuid.UUID) (*domain.ProjectCollaborator, error) {
+	collaborator, err := uc.CollaboratorRepo.FindCollaboratorByID(collaboratorID)
+	if err != nil {
+		return nil, ErrCollaboratorNotFound
+	}
+	if !Can(Principal{UserID: userID}, ActionDelete, collaborator) {
+		return collaborator, ErrPermissionDenied
+	}
+
+	if err := uc.CollaboratorRepo.DeleteCollaborator(collaborator.ID); err != nil {
+		return collaborator, fmt.Errorf("failed to remove collaborator: %w", err)
+	}
+	return collaborator, nil
+}
+
+// loadOwnInvitation loads an unanswered invitation, treating invitations to other users as missing.
+func (uc *CollaboratorUseCase) loadOwnInvitation(userID, invitationID uuid.UUID) (*domain.ProjectCollaborator, error) {
+	invitation, err := uc.CollaboratorRepo.FindCollaboratorByID(invitationID)
+	if err != nil || invitation.IsAccepted() || !Can(Principal{UserID: userID}, ActionRespond, invitation) {
+		return nil, ErrCollaboratorNotFound
+	}
+	return invitation, nil
+}
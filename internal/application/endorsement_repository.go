@@ -0,0 +1,14 @@
+package application
+
+import (
+	"devsearch-go/internal/domain"
+
+	"github.com/google/uuid"
+)
+
+// EndorsementRepository defines the interface for skill endorsement data operations.
+type EndorsementRepository interface {
+	CreateEndorsement(endorsement *domain.Endorsement) error
+	FindEndorsement(skillID, endorserID uuid.UUID) (*domain.Endorsement, error)
+	DeleteEndorsement(id uuid.UUID) error
+}
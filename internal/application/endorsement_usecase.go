@@ -0,0 +1,85 @@
+package application
+
+import (
+	"devsearch-go/internal/domain"
+
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+var (
+	// ErrSelfEndorsement is returned when a developer tries to endorse their own skill.
+	ErrSelfEndorsement = errors.New("you cannot endorse your own skills")
+	// ErrAlreadyEndorsed is returned when the user already endorsed the skill.
+	ErrAlreadyEndorsed = errors.New("you already endorsed this skill")
+	// ErrNotEndorsed is returned when revoking an endorsement that does not exist.
+	ErrNotEndorsed = errors.New("you have not endorsed this skill")
+)
+
+// EndorsementUseCase defines the business logic for skill endorsements.
+type EndorsementUseCase struct {
+	EndorsementRepo EndorsementRepository
+	SkillRepo       SkillRepository
+	ProfileRepo     ProfileRepository
+}
+
+// NewEndorsementUseCase creates a new EndorsementUseCase.
+func NewEndorsementUseCase(endorsementRepo EndorsementRepository, skillRepo SkillRepository, profileRepo ProfileRepository) *EndorsementUseCase {
+	return &EndorsementUseCase{
+		EndorsementRepo: endorsementRepo,
+		SkillRepo:       skillRepo,
+		ProfileRepo:     profileRepo,
+	}
+}
+
+// EndorseSkill records the user's endorsement of another developer's skill.
+// It returns the endorsed skill so callers can link back to its profile.
+func (uc *EndorsementUseCase) EndorseSkill(userID, skillID uuid.UUID) (*domain.Skill, error) {
+	skill, endorser, err := uc.loadSkillAndEndorser(userID, skillID)
+	if err != nil {
+		return nil, err
+	}
+	if skill.OwnerID == endorser.ID {
+		return skill, ErrSelfEndorsement
+	}
+	if _, err := uc.EndorsementRepo.FindEndorsement(skill.ID, endorser.ID); err == nil {
+		return skill, ErrAlreadyEndorsed
+	}
+
+	endorsement := domain.Endorsement{SkillID: skill.ID, EndorserID: endorser.ID}
+	if err := uc.EndorsementRepo.CreateEndorsement(&endorsement); err != nil {
+		return skill, fmt.Errorf("failed to endorse skill: %w", err)
+	}
+	return skill, nil
+}
+
+// RevokeEndorsement removes the user's endorsement of a skill.
+func (uc *EndorsementUseCase) RevokeEndorsement(userID, skillID uuid.UUID) (*domain.Skill, error) {
+	skill, endorser, err := uc.loadSkillAndEndorser(userID, skillID)
+	if err != nil {
+		return nil, err
+	}
+	endorsement, err := uc.EndorsementRepo.FindEndorsement(skill.ID, endorser.ID)
+	if err != nil {
+		return skill, ErrNotEndorsed
+	}
+	if err := uc.EndorsementRepo.DeleteEndorsement(endorsement.ID); err != nil {
+		return skill, fmt.Errorf("failed to revoke endorsement: %w", err)
+	}
+	return skill, nil
+}
+
+// loadSkillAndEndorser loads the skill to endorse and the profile of the endorsing user.
+func (uc *EndorsementUseCase) loadSkillAndEndorser(userID, skillID uuid.UUID) (*domain.Skill, *domain.Profile, error) {
+	skill, err := uc.SkillRepo.FindSkillByID(skillID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("skill not found: %w", err)
+	}
+	endorser, err := uc.ProfileRepo.FindProfileByUserID(userID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("profile not found for user: %w", err)
+	}
+	return skill, endorser, nil
+}
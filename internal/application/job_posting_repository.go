@@ -0,0 +1,29 @@
+package application
+
+import (
+	"devsearch-go/internal/domain"
+
+	"github.com/google/uuid"
+)
+
+// JobPostingFilter narrows the public job posting listing. Empty fields do not filter.
+type JobPostingFilter struct {
+	// Query matches the title, company or description, or names a required skill or one of its aliases.
+	Query string
+	// Location matches postings whose location contains it.
+	Location string
+	// RemoteOnly restricts the listing to remote positions.
+	RemoteOnly bool
+}
+
+// JobPostingRepository defines the interface for data operations on job postings.
+type JobPostingRepository interface {
+	FindJobPostings(filter JobPostingFilter, page, limit int) ([]domain.JobPosting, int64, error)
+	FindJobPostingByID(id uuid.UUID) (*domain.JobPosting, error)
+	FindJobPostingsByOwner(ownerID uuid.UUID) ([]domain.JobPosting, error)
+	FindJobPostingsRequiringSkills(catalogSkillIDs []uuid.UUID, tagKeys []string, limit int) ([]domain.JobPosting, error)
+	FindProfilesWithSkillsOrTags(catalogSkillIDs []uuid.UUID, tagKeys []string, limit int) ([]domain.Profile, error)
+	CreateJobPosting(posting *domain.JobPosting) error
+	UpdateJobPosting(posting *domain.JobPosting) error
+	DeleteJobPosting(id uuid.UUID) error
+}
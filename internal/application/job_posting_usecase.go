@@ -0,0 +1,411 @@
+package application
+
+import (
+	"devsearch-go/internal/domain"
+
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// DefaultJobMatchLimit is the number of ranked matches shown for a posting or a profile.
+	DefaultJobMatchLimit = 10
+	// maxRequiredSkills bounds the number of skills a job posting can ask for.
+	maxRequiredSkills = 20
+	// matchCandidateLimit bounds how many profiles or postings are scored for one ranking.
+	matchCandidateLimit = 200
+	// listedSkillWeight is the share of a required skill's credit earned by listing it on the profile.
+	listedSkillWeight = 0.7
+	// projectTagWeight is the share of a required skill's credit earned by tagging a project with it.
+	projectTagWeight = 0.3
+)
+
+var (
+	// ErrJobPostingNotFound is returned for job postings that do not exist or, when editing, belong to someone else.
+	ErrJobPostingNotFound = errors.New("job posting not found")
+	// ErrJobPostingNotOwned is returned when someone other than the author asks for a posting's matching developers.
+	ErrJobPostingNotOwned = errors.New("only the author of a job posting can see its matching developers")
+)
+
+// JobMatch describes how well a profile fits a job posting. Score runs from 0 to 100.
+type JobMatch struct {
+	Score         int
+	MatchedSkills []string
+	MissingSkills []string
+}
+
+// ProfileMatch is a developer ranked for a job posting.
+type ProfileMatch struct {
+	Profile domain.Profile
+	JobMatch
+}
+
+// JobPostingMatch is a job posting ranked for a developer.
+type JobPostingMatch struct {
+	Posting domain.JobPosting
+	JobMatch
+}
+
+// JobPostingUseCase defines the business logic for job postings and developer-job matching.
+type JobPostingUseCase struct {
+	JobPostingRepo JobPostingRepository
+	ProfileRepo    ProfileRepository
+	CatalogRepo    SkillCatalogRepository
+}
+
+// NewJobPostingUseCase creates a new JobPostingUseCase.
+func NewJobPostingUseCase(jobPostingRepo JobPostingRepository, profileRepo ProfileRepository, catalogRepo SkillCatalogRepository) *JobPostingUseCase {
+	return &JobPostingUseCase{
+		JobPostingRepo: jobPostingRepo,
+		ProfileRepo:    profileRepo,
+		CatalogRepo:    catalogRepo,
+	}
+}
+
+// GetJobPostings retrieves the public job posting listing, newest first.
+func (uc *JobPostingUseCase) GetJobPostings(filter JobPostingFilter, page, limit int) ([]domain.JobPosting, int64, error) {
+	filter.Query = strings.TrimSpace(filter.Query)
+	filter.Location = strings.TrimSpace(filter.Location)
+	return uc.JobPostingRepo.FindJobPostings(filter, page, limit)
+}
+
+// GetJobPosting retrieves a single job posting with its required skills.
+func (uc *JobPostingUseCase) GetJobPosting(id uuid.UUID) (*domain.JobPosting, error) {
+	posting, err := uc.JobPostingRepo.FindJobPostingByID(id)
+	if err != nil {
+		return nil, ErrJobPostingNotFound
+	}
+	return posting, nil
+}
+
+// GetUserJobPosting retrieves a job posting the user published.
+func (uc *JobPostingUseCase) GetUserJobPosting(postingID, userID uuid.UUID) (*domain.JobPosting, error) {
+	return uc.loadOwnJobPosting(userID, postingID, ActionView)
+}
+
+// GetUserJobPostings retrieves the job postings the user published.
+func (uc *JobPostingUseCase) GetUserJobPostings(userID uuid.UUID) ([]domain.JobPosting, error) {
+	owner, err := uc.ProfileRepo.FindProfileByUserID(userID)
+	if err != nil {
+		return nil, fmt.Errorf("profile not found for user: %w", err)
+	}
+	return uc.JobPostingRepo.FindJobPostingsByOwner(owner.ID)
+}
+
+// CreateJobPosting publishes a job posting for the user. Required skills are linked to the
+// skill catalog, so aliases of the same skill are stored once under its canonical name.
+func (uc *JobPostingUseCase) CreateJobPosting(userID uuid.UUID, posting *domain.JobPosting, skillNames []string) error {
+	if err := validateJobPosting(posting); err != nil {
+		return err
+	}
+	skills, err := uc.resolveRequiredSkills(skillNames)
+	if err != nil {
+		return err
+	}
+	owner, err := uc.ProfileRepo.FindProfileByUserID(userID)
+	if err != nil {
+		return fmt.Errorf("profile not found for user: %w", err)
+	}
+
+	posting.OwnerID = owner.ID
+	posting.RequiredSkills = skills
+	if err := uc.JobPostingRepo.CreateJobPosting(posting); err != nil {
+		return fmt.Errorf("failed to create job posting: %w", err)
+	}
+	return nil
+}
+
+// UpdateJobPosting updates a job posting the user published, replacing its required skills.
+func (uc *JobPostingUseCase) UpdateJobPosting(postingID, userID uuid.UUID, data *domain.JobPosting, skillNames []string) (*domain.JobPosting, error) {
+	if err := validateJobPosting(data); err != nil {
+		return nil, err
+	}
+	skills, err := uc.resolveRequiredSkills(skillNames)
+	if err != nil {
+		return nil, err
+	}
+	posting, err := uc.loadOwnJobPosting(userID, postingID, ActionEdit)
+	if err != nil {
+		return nil, err
+	}
+
+	posting.Company = data.Company
+	posting.Title = data.Title
+	posting.Location = data.Location
+	posting.Remote = data.Remote
+	posting.Description = data.Description
+	posting.RequiredSkills = skills
+	if err := uc.JobPostingRepo.UpdateJobPosting(posting); err != nil {
+		return nil, fmt.Errorf("failed to update job posting: %w", err)
+	}
+	return posting, nil
+}
+
+// DeleteJobPosting deletes a job posting the user published.
+func (uc *JobPostingUseCase) DeleteJobPosting(postingID, userID uuid.UUID) error {
+	posting, err := uc.loadOwnJobPosting(userID, postingID, ActionDelete)
+	if err != nil {
+		return err
+	}
+	if err := uc.JobPostingRepo.DeleteJobPosting(posting.ID); err != nil {
+		return fmt.Errorf("failed to delete job posting: %w", err)
+	}
+	return nil
+}
+
+// RankProfilesForPosting ranks public developer profiles by how well they match one of the
+// user's job postings, best match first. Profiles that match none of the required skills are left out.
+func (uc *JobPostingUseCase) RankProfilesForPosting(userID, postingID uuid.UUID, limit int) ([]ProfileMatch, error) {
+	posting, err := uc.GetJobPosting(postingID)
+	if err != nil {
+		return nil, err
+	}
+	principal, err := loadPrincipal(uc.ProfileRepo, userID)
+	if err != nil || !Can(principal, ActionView, posting) {
+		return nil, ErrJobPostingNotOwned
+	}
+
+	catalogSkillIDs := make([]uuid.UUID, 0, len(posting.RequiredSkills))
+	var tagKeys []string
+	for _, skill := range posting.RequiredSkills {
+		catalogSkillIDs = append(catalogSkillIDs, skill.CatalogSkillID)
+		for key := range requiredSkillKeys(skill) {
+			tagKeys = append(tagKeys, key)
+		}
+	}
+	if len(catalogSkillIDs) == 0 {
+		return []ProfileMatch{}, nil
+	}
+
+	profiles, err := uc.JobPostingRepo.FindProfilesWithSkillsOrTags(catalogSkillIDs, tagKeys, matchCandidateLimit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load matching profiles: %w", err)
+	}
+
+	matches := make([]ProfileMatch, 0, len(profiles))
+	for _, profile := range profiles {
+		if profile.ID == posting.OwnerID {
+			continue
+		}
+		// Only what the recruiter may see counts, so drafts and hidden projects never shape the score
+		if applyProfilePrivacy(&profile, userID) != nil {
+			continue
+		}
+		match := ScoreJobMatch(posting, &profile)
+		if match.Score == 0 {
+			continue
+		}
+		matches = append(matches, ProfileMatch{Profile: profile, JobMatch: match})
+	}
+	sort.SliceStable(matches, func(i, j int) bool { return matches[i].Score > matches[j].Score })
+	if len(matches) > limit {
+		matches = matches[:limit]
+	}
+	return matches, nil
+}
+
+// RankPostingsForProfile ranks job postings by how well the user's profile matches them, best
+// match first. Postings that ask for none of the user's skills or project tags are left out.
+func (uc *JobPostingUseCase) RankPostingsForProfile(userID uuid.UUID, limit int) ([]JobPostingMatch, error) {
+	profile, err := uc.loadMatchProfile(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	var catalogSkillIDs []uuid.UUID
+	for _, skill := range profile.Skills {
+		if skill.CatalogSkillID != nil {
+			catalogSkillIDs = append(catalogSkillIDs, *skill.CatalogSkillID)
+		}
+	}
+	tagKeys := make([]string, 0)
+	for key := range projectTagKeys(profile) {
+		tagKeys = append(tagKeys, key)
+	}
+	if len(catalogSkillIDs) == 0 && len(tagKeys) == 0 {
+		return []JobPostingMatch{}, nil
+	}
+
+	postings, err := uc.JobPostingRepo.FindJobPostingsRequiringSkills(catalogSkillIDs, tagKeys, matchCandidateLimit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load matching job postings: %w", err)
+	}
+
+	matches := make([]JobPostingMatch, 0, len(postings))
+	for _, posting := range postings {
+		if posting.OwnerID == profile.ID {
+			continue
+		}
+		if match := ScoreJobMatch(&posting, profile); match.Score > 0 {
+			matches = append(matches, JobPostingMatch{Posting: posting, JobMatch: match})
+		}
+	}
+	sort.SliceStable(matches, func(i, j int) bool { return matches[i].Score > matches[j].Score })
+	if len(matches) > limit {
+		matches = matches[:limit]
+	}
+	return matches, nil
+}
+
+// GetJobMatch scores the user's own profile against a job posting.
+func (uc *JobPostingUseCase) GetJobMatch(userID uuid.UUID, posting *domain.JobPosting) (*JobMatch, error) {
+	profile, err := uc.loadMatchProfile(userID)
+	if err != nil {
+		return nil, err
+	}
+	match := ScoreJobMatch(posting, profile)
+	return &match, nil
+}
+
+// ScoreJobMatch scores a profile against a job posting. Every required skill carries the same
+// weight; listing it on the profile earns most of its credit and tagging a project with it earns
+// the rest. The posting needs its required skills' catalog entries and aliases loaded, the
+// profile its skills and its projects' tags.
+func ScoreJobMatch(posting *domain.JobPosting, profile *domain.Profile) JobMatch {
+	match := JobMatch{MatchedSkills: []string{}, MissingSkills: []string{}}
+	if len(posting.RequiredSkills) == 0 {
+		return match
+	}
+
+	listed := make(map[uuid.UUID]bool, len(profile.Skills))
+	for _, skill := range profile.Skills {
+		if skill.CatalogSkillID != nil {
+			listed[*skill.CatalogSkillID] = true
+		}
+	}
+	tagged := projectTagKeys(profile)
+
+	credit := 0.0
+	for _, skill := range posting.RequiredSkills {
+		skillCredit := 0.0
+		if listed[skill.CatalogSkillID] {
+			skillCredit += listedSkillWeight
+		}
+		for key := range requiredSkillKeys(skill) {
+			if tagged[key] {
+				skillCredit += projectTagWeight
+				break
+			}
+		}
+
+		if skillCredit > 0 {
+			match.MatchedSkills = append(match.MatchedSkills, skill.Name)
+		} else {
+			match.MissingSkills = append(match.MissingSkills, skill.Name)
+		}
+		credit += skillCredit
+	}
+	match.Score = int(math.Round(100 * credit / float64(len(posting.RequiredSkills))))
+	return match
+}
+
+// requiredSkillKeys returns the normalized names a project tag may use for a required skill:
+// its catalog slug and aliases, or its own name when the catalog entry is not loaded.
+func requiredSkillKeys(skill domain.JobPostingSkill) map[string]bool {
+	keys := map[string]bool{domain.NormalizeSkillName(skill.Name): true}
+	if skill.CatalogSkill != nil {
+		keys[skill.CatalogSkill.Slug] = true
+		for _, alias := range skill.CatalogSkill.Aliases {
+			keys[alias.Alias] = true
+		}
+	}
+	return keys
+}
+
+// projectTagKeys returns the normalized names of the tags on a profile's projects.
+func projectTagKeys(profile *domain.Profile) map[string]bool {
+	keys := make(map[string]bool)
+	for _, project := range profile.Projects {
+		for _, tag := range project.Tags {
+			if key := domain.NormalizeSkillName(tag.Name); key != "" {
+				keys[key] = true
+			}
+		}
+	}
+	return keys
+}
+
+// loadMatchProfile loads the user's profile with the skills and project tags matching relies on.
+func (uc *JobPostingUseCase) loadMatchProfile(userID uuid.UUID) (*domain.Profile, error) {
+	owner, err := uc.ProfileRepo.FindProfileByUserID(userID)
+	if err != nil {
+		return nil, fmt.Errorf("profile not found for user: %w", err)
+	}
+	profile, err := uc.ProfileRepo.FindProfileByID(owner.ID)
+	if err != nil {
+		return nil, fmt.Errorf("profile not found: %w", err)
+	}
+	return profile, nil
+}
+
+// loadOwnJobPosting loads a job posting the user may perform the action on, treating postings
+// of other users as missing.
+func (uc *JobPostingUseCase) loadOwnJobPosting(userID, postingID uuid.UUID, action Action) (*domain.JobPosting, error) {
+	principal, err := loadPrincipal(uc.ProfileRepo, userID)
+	if err != nil {
+		return nil, err
+	}
+	posting, err := uc.JobPostingRepo.FindJobPostingByID(postingID)
+	if err != nil || !Can(principal, action, posting) {
+		return nil, ErrJobPostingNotFound
+	}
+	return posting, nil
+}
+
+// resolveRequiredSkills turns the skill names entered on a posting form into catalog-linked
+// required skills, dropping blanks and duplicates. Names the catalog does not know are
+// rejected, since matching relies on catalog slugs and aliases. The number of skills is checked before the
+// catalog is queried, so an oversized form cannot touch the catalog.
+func (uc *JobPostingUseCase) resolveRequiredSkills(skillNames []string) ([]domain.JobPostingSkill, error) {
+	var names []string
+	seenNames := make(map[string]bool)
+	for _, name := range skillNames {
+		key := domain.NormalizeSkillName(name)
+		if key == "" || seenNames[key] {
+			continue
+		}
+		seenNames[key] = true
+		names = append(names, name)
+	}
+	if len(names) > maxRequiredSkills {
+		return nil, fmt.Errorf("a job posting can require at most %d skills", maxRequiredSkills)
+	}
+
+	var skills []domain.JobPostingSkill
+	seen := make(map[uuid.UUID]bool)
+	for _, name := range names {
+		catalogSkill, err := findCatalogSkill(uc.CatalogRepo, name)
+		if err != nil {
+			return nil, err
+		}
+		// Two names may be aliases of the same catalog skill.
+		if seen[catalogSkill.ID] {
+			continue
+		}
+		seen[catalogSkill.ID] = true
+		skills = append(skills, domain.JobPostingSkill{CatalogSkillID: catalogSkill.ID, Name: catalogSkill.Name})
+	}
+	return skills, nil
+}
+
+// validateJobPosting trims the fields of a posting and checks that they are present and short enough.
+func validateJobPosting(posting *domain.JobPosting) error {
+	posting.Company = strings.TrimSpace(posting.Company)
+	posting.Title = strings.TrimSpace(posting.Title)
+	posting.Location = strings.TrimSpace(posting.Location)
+	if posting.Company == "" || posting.Title == "" {
+		return fmt.Errorf("company and title are required")
+	}
+	if len(posting.Company) > 255 || len(posting.Title) > 255 || len(posting.Location) > 255 {
+		return fmt.Errorf("company, title and location must be at most 255 characters")
+	}
+	if posting.Location == "" && !posting.Remote {
+		return fmt.Errorf("location is required for positions that are not remote")
+	}
+	return nil
+}
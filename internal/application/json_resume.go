@@ -0,0 +1,87 @@
+package application
+
+// JSONResumeSchema is the schema URL referenced by exported documents.
+const JSONResumeSchema = "https://raw.githubusercontent.com/jsonresume/resume-schema/v1.0.0/schema.json"
+
+// JSONResume is a document following the JSON Resume schema (https://jsonresume.org/schema).
+// Only the sections DevSearch can fill or consume are modelled.
+type JSONResume struct {
+	Schema    string                `json:"$schema,omitempty"`
+	Basics    JSONResumeBasics      `json:"basics"`
+	Work      []JSONResumeWork      `json:"work"`
+	Education []JSONResumeEducation `json:"education"`
+	Skills    []JSONResumeSkill     `json:"skills"`
+	Projects  []JSONResumeProject   `json:"projects"`
+	Meta      *JSONResumeMeta       `json:"meta,omitempty"`
+}
+
+// JSONResumeBasics holds the "basics" section of a JSON Resume.
+type JSONResumeBasics struct {
+	Name     string              `json:"name"`
+	Label    string              `json:"label,omitempty"`
+	Image    string              `json:"image,omitempty"`
+	Email    string              `json:"email,omitempty"`
+	URL      string              `json:"url,omitempty"`
+	Summary  string              `json:"summary,omitempty"`
+	Location *JSONResumeLocation `json:"location,omitempty"`
+	Profiles []JSONResumeProfile `json:"profiles,omitempty"`
+}
+
+// JSONResumeLocation holds the "basics.location" section of a JSON Resume.
+type JSONResumeLocation struct {
+	Address     string `json:"address,omitempty"`
+	PostalCode  string `json:"postalCode,omitempty"`
+	City        string `json:"city,omitempty"`
+	CountryCode string `json:"countryCode,omitempty"`
+	Region      string `json:"region,omitempty"`
+}
+
+// JSONResumeProfile is a social network entry in "basics.profiles".
+type JSONResumeProfile struct {
+	Network  string `json:"network"`
+	Username string `json:"username,omitempty"`
+	URL      string `json:"url"`
+}
+
+// JSONResumeWork is an entry of the "work" section.
+type JSONResumeWork struct {
+	Name       string   `json:"name"`
+	Position   string   `json:"position,omitempty"`
+	Location   string   `json:"location,omitempty"`
+	StartDate  string   `json:"startDate,omitempty"`
+	EndDate    string   `json:"endDate,omitempty"`
+	Summary    string   `json:"summary,omitempty"`
+	Highlights []string `json:"highlights,omitempty"`
+}
+
+// JSONResumeEducation is an entry of the "education" section.
+type JSONResumeEducation struct {
+	Institution string `json:"institution"`
+	Area        string `json:"area,omitempty"`
+	StudyType   string `json:"studyType,omitempty"`
+	StartDate   string `json:"startDate,omitempty"`
+	EndDate     string `json:"endDate,omitempty"`
+}
+
+// JSONResumeSkill is an entry of the "skills" section.
+type JSONResumeSkill struct {
+	Name     string   `json:"name"`
+	Level    string   `json:"level,omitempty"`
+	Keywords []string `json:"keywords,omitempty"`
+}
+
+// JSONResumeProject is an entry of the "projects" section.
+type JSONResumeProject struct {
+	Name        string   `json:"name"`
+	Description string   `json:"description,omitempty"`
+	URL         string   `json:"url,omitempty"`
+	Keywords    []string `json:"keywords,omitempty"`
+	StartDate   string   `json:"startDate,omitempty"`
+}
+
+// JSONResumeMeta holds the "meta" section of a JSON Resume.
+type JSONResumeMeta struct {
+	Canonical    string `json:"canonical,omitempty"`
+	Version      string `json:"version,omitempty"`
+	LastModified string `json:"lastModified,omitempty"`
+}
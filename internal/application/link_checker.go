@@ -0,0 +1,19 @@
+package application
+
+import "time"
+
+// LinkCheckResult is the outcome of checking one link.
+type LinkCheckResult struct {
+	URL        string
+	StatusCode int    // Of the last response, after redirects; 0 when none was received
+	Err        string // Why no response was received
+	Attempts   int
+	Duration   time.Duration
+}
+
+// LinkChecker defines the interface for checking whether links answer. Implementations
+// decide how many requests run at once, how often one host is asked and when to retry.
+type LinkChecker interface {
+	// CheckLinks checks links and returns their results in the same order.
+	CheckLinks(links []string) []LinkCheckResult
+}
@@ -0,0 +1,22 @@
+package application
+
+import (
+	"devsearch-go/internal/domain"
+
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// LinkHealthRepository defines the interface for data operations on the health of the demo,
+// source and social links of projects and profiles.
+type LinkHealthRepository interface {
+	// FindLinksDueForCheck retrieves up to limit current links that were never checked, changed
+	// since they were, or were last checked before healthyBefore, or before failingBefore when
+	// their last check failed. Least recently checked links come first.
+	FindLinksDueForCheck(healthyBefore, failingBefore time.Time, limit int) ([]LinkTarget, error)
+	FindOwnerLinks(ownerID uuid.UUID) ([]LinkTarget, error)
+	FindLinkChecks(ownerID uuid.UUID, since time.Time) ([]domain.LinkCheck, error)
+	SaveLinkCheck(status *domain.LinkStatus, check *domain.LinkCheck) error
+	DeleteLinkChecksBefore(before time.Time) error
+}
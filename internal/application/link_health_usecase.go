@@ -0,0 +1,263 @@
+package application
+
+import (
+	"devsearch-go/internal/domain"
+
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// linkHealthyRecheckAge is how long a working link waits before it is checked again.
+	linkHealthyRecheckAge = 24 * time.Hour
+	// linkFailingRecheckAge is how long a failing link waits before it is checked again, so a
+	// breakage is confirmed or cleared sooner.
+	linkFailingRecheckAge = 3 * time.Hour
+	// linkBrokenAfterFailures is how many checks in a row must fail before a link is flagged broken.
+	linkBrokenAfterFailures = 3
+	// linkCheckBatchSize limits how many links one run of the checker checks.
+	linkCheckBatchSize = 200
+	// linkCheckRetention is how long the status history of links is kept.
+	linkCheckRetention = 90 * 24 * time.Hour
+	// linkHistoryLength is how many past checks are shown for each link.
+	linkHistoryLength = 10
+)
+
+// LinkTarget is a demo, source or social link currently set on a project or profile, with the
+// status stored for its field, if any.
+type LinkTarget struct {
+	Kind           string
+	TargetID       uuid.UUID
+	TargetTitle    string    // Title of the project, or name of the profile
+	OwnerID        uuid.UUID // User owning the project or profile
+	OwnerProfileID uuid.UUID // Profile of the owner, which their inbox belongs to
+	Field          string
+	URL            string
+	Status         *domain.LinkStatus // Nil while the field was never checked
+}
+
+// Label names the link for people.
+func (target LinkTarget) Label() string {
+	return domain.LinkFieldLabels[target.Field]
+}
+
+// EditURL returns the path of the form the link is changed in.
+func (target LinkTarget) EditURL() string {
+	if target.Kind == domain.LinkKindProject {
+		return "/update-project/" + target.TargetID.String()
+	}
+	return "/edit-account"
+}
+
+// CurrentStatus returns the status of the link, or nil while the current link was never checked.
+func (target LinkTarget) CurrentStatus() *domain.LinkStatus {
+	if target.Status == nil || target.Status.URL != target.URL {
+		return nil
+	}
+	return target.Status
+}
+
+// LinkHealthEntry is a link of the user's with its status and its latest checks, newest first.
+type LinkHealthEntry struct {
+	Target  LinkTarget
+	Status  *domain.LinkStatus // Nil while the link was never checked
+	History []domain.LinkCheck
+}
+
+// LinkHealthUseCase defines the business logic for checking the demo, source and social links
+// of projects and profiles and flagging broken ones to their owners.
+type LinkHealthUseCase struct {
+	LinkRepo    LinkHealthRepository
+	MessageRepo MessageRepository
+	Checker     LinkChecker
+}
+
+// NewLinkHealthUseCase creates a new LinkHealthUseCase.
+func NewLinkHealthUseCase(linkRepo LinkHealthRepository, messageRepo MessageRepository, checker LinkChecker) *LinkHealthUseCase {
+	return &LinkHealthUseCase{LinkRepo: linkRepo, MessageRepo: messageRepo, Checker: checker}
+}
+
+// CheckLinks checks the links that are due, records each check in their history, and flags
+// links that failed linkBrokenAfterFailures checks in a row, telling their owners through the
+// inbox once per breakage. Old history is pruned. It is run by a background job.
+func (uc *LinkHealthUseCase) CheckLinks() error {
+	now := time.Now()
+	targets, err := uc.LinkRepo.FindLinksDueForCheck(now.Add(-linkHealthyRecheckAge), now.Add(-linkFailingRecheckAge), linkCheckBatchSize)
+	if err != nil {
+		return fmt.Errorf("failed to load links to check: %w", err)
+	}
+
+	// A link used in several places is checked once
+	var links []string
+	seen := make(map[string]bool)
+	for _, target := range targets {
+		if !seen[target.URL] {
+			seen[target.URL] = true
+			links = append(links, target.URL)
+		}
+	}
+	results := make(map[string]LinkCheckResult, len(links))
+	for i, result := range uc.Checker.CheckLinks(links) {
+		results[links[i]] = result
+	}
+
+	var errs []error
+	for i := range targets {
+		target := &targets[i]
+		if err := uc.recordLinkCheck(target, results[target.URL]); err != nil {
+			errs = append(errs, fmt.Errorf("%s of %s %s: %w", target.Field, target.Kind, target.TargetID, err))
+		}
+	}
+	if err := uc.LinkRepo.DeleteLinkChecksBefore(now.Add(-linkCheckRetention)); err != nil {
+		errs = append(errs, fmt.Errorf("failed to prune link history: %w", err))
+	}
+	return errors.Join(errs...)
+}
+
+// GetLinkHealth retrieves the current links of the user's profile and projects with their
+// status and latest checks.
+func (uc *LinkHealthUseCase) GetLinkHealth(userID uuid.UUID) ([]LinkHealthEntry, error) {
+	targets, err := uc.LinkRepo.FindOwnerLinks(userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load links: %w", err)
+	}
+	checks, err := uc.LinkRepo.FindLinkChecks(userID, time.Now().Add(-linkCheckRetention))
+	if err != nil {
+		return nil, fmt.Errorf("failed to load link history: %w", err)
+	}
+
+	entries := make([]LinkHealthEntry, len(targets))
+	index := make(map[string]int, len(targets))
+	for i, target := range targets {
+		entries[i] = LinkHealthEntry{Target: target, Status: target.CurrentStatus()}
+		index[linkKey(target.Kind, target.TargetID, target.Field, target.URL)] = i
+	}
+	for _, check := range checks {
+		i, ok := index[linkKey(check.Kind, check.TargetID, check.Field, check.URL)]
+		if ok && len(entries[i].History) < linkHistoryLength {
+			entries[i].History = append(entries[i].History, check)
+		}
+	}
+	return entries, nil
+}
+
+// CountBrokenLinks counts the current links of the user's profile and projects flagged broken.
+func (uc *LinkHealthUseCase) CountBrokenLinks(userID uuid.UUID) (int, error) {
+	targets, err := uc.LinkRepo.FindOwnerLinks(userID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to load links: %w", err)
+	}
+	broken := 0
+	for _, target := range targets {
+		if status := target.CurrentStatus(); status != nil && status.IsBroken() {
+			broken++
+		}
+	}
+	return broken, nil
+}
+
+// recordLinkCheck updates the status of a link with the result of a check and adds the check
+// to its history. A link that changed since its last check starts over.
+func (uc *LinkHealthUseCase) recordLinkCheck(target *LinkTarget, result LinkCheckResult) error {
+	checkedAt := time.Now()
+	status := target.CurrentStatus()
+	if status == nil {
+		status = &domain.LinkStatus{Kind: target.Kind, TargetID: target.TargetID, Field: target.Field, URL: target.URL}
+	}
+	status.OwnerID = target.OwnerID
+	status.State = linkState(result)
+	status.StatusCode = result.StatusCode
+	status.LastError = truncateAuditValue(linkFailureReason(result), 255)
+	status.CheckedAt = checkedAt
+
+	// Blocked checks say nothing about the link, so they neither clear nor extend a breakage
+	switch status.State {
+	case domain.LinkStateOK:
+		status.ConsecutiveFailures = 0
+		status.BrokenSince = nil
+		status.NotifiedAt = nil
+	case domain.LinkStateFailed:
+		status.ConsecutiveFailures++
+		if status.ConsecutiveFailures >= linkBrokenAfterFailures && status.BrokenSince == nil {
+			status.BrokenSince = &checkedAt
+		}
+	}
+	if status.IsBroken() && status.NotifiedAt == nil {
+		if err := uc.notifyBrokenLink(target, status); err != nil {
+			log.Printf("Failed to tell user %s about broken link %s: %v", target.OwnerID, target.URL, err)
+		} else {
+			status.NotifiedAt = &checkedAt
+		}
+	}
+
+	check := domain.LinkCheck{
+		Kind:       target.Kind,
+		TargetID:   target.TargetID,
+		Field:      target.Field,
+		OwnerID:    target.OwnerID,
+		URL:        target.URL,
+		State:      status.State,
+		StatusCode: result.StatusCode,
+		Error:      status.LastError,
+		Attempts:   result.Attempts,
+		DurationMs: int(result.Duration.Milliseconds()),
+		CheckedAt:  checkedAt,
+	}
+	return uc.LinkRepo.SaveLinkCheck(status, &check)
+}
+
+// notifyBrokenLink tells the owner of a link that it was flagged broken through their inbox.
+func (uc *LinkHealthUseCase) notifyBrokenLink(target *LinkTarget, status *domain.LinkStatus) error {
+	where := "your profile"
+	if target.Kind == domain.LinkKindProject {
+		where = fmt.Sprintf("your project \"%s\"", target.TargetTitle)
+	}
+	message := domain.Message{
+		RecipientID: target.OwnerProfileID,
+		Name:        "DevSearch",
+		Subject:     truncateAuditValue("Broken link on "+where, 255),
+		Body: fmt.Sprintf("%s on %s (%s) failed %d checks in a row: %s.\n\nUpdate or remove it at %s\nSee all your links at /link-health",
+			target.Label(), where, target.URL, status.ConsecutiveFailures, status.LastError, target.EditURL()),
+	}
+	return uc.MessageRepo.CreateMessage(&message)
+}
+
+// linkState classifies the result of a check. Sites that turn away automated requests, such as
+// LinkedIn with its non-standard 999 status, are blocked rather than failed.
+func linkState(result LinkCheckResult) string {
+	switch code := result.StatusCode; {
+	case code >= 200 && code < 400:
+		return domain.LinkStateOK
+	case code == http.StatusUnauthorized, code == http.StatusForbidden, code == http.StatusTooManyRequests, code == 999:
+		return domain.LinkStateBlocked
+	default:
+		return domain.LinkStateFailed
+	}
+}
+
+// linkFailureReason describes why a check did not succeed, or returns "" when it did.
+func linkFailureReason(result LinkCheckResult) string {
+	switch linkState(result) {
+	case domain.LinkStateOK:
+		return ""
+	case domain.LinkStateBlocked:
+		return fmt.Sprintf("the site refused the automated check (HTTP %d)", result.StatusCode)
+	}
+	if result.StatusCode != 0 {
+		return fmt.Sprintf("HTTP %d %s", result.StatusCode, http.StatusText(result.StatusCode))
+	}
+	if result.Err != "" {
+		return result.Err
+	}
+	return "no response"
+}
+
+// linkKey identifies a link of a field of a profile or project.
+func linkKey(kind string, targetID uuid.UUID, field, url string) string {
+	return kind + "|" + targetID.String() + "|" + field + "|" + url
+}
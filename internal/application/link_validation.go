@@ -0,0 +1,82 @@
+package application
+
+import (
+	"devsearch-go/internal/domain"
+
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"unicode"
+)
+
+// maxLinkLength is the size of the link columns.
+const maxLinkLength = 255
+
+// ErrInvalidLink is returned, wrapped in a *LinkError, for demo, source and social links that
+// are not a web address.
+var ErrInvalidLink = errors.New("invalid link")
+
+// LinkError reports which link failed validation.
+type LinkError struct {
+	Field string
+	Link  string
+}
+
+func (e *LinkError) Error() string {
+	return fmt.Sprintf("%s must be a web address such as https://example.com", domain.LinkFieldLabels[e.Field])
+}
+
+func (e *LinkError) Unwrap() error {
+	return ErrInvalidLink
+}
+
+// normalizeLink validates a link entered for field and returns it trimmed, with "https://"
+// added when the scheme was left out. An empty link is valid: the field is optional.
+func normalizeLink(field, link string) (string, error) {
+	link = strings.TrimSpace(link)
+	if link == "" {
+		return "", nil
+	}
+	if !strings.Contains(link, "://") && !strings.HasPrefix(link, "/") {
+		link = "https://" + link
+	}
+
+	invalid := &LinkError{Field: field, Link: link}
+	if len(link) > maxLinkLength || strings.IndexFunc(link, func(r rune) bool { return unicode.IsSpace(r) || unicode.IsControl(r) }) >= 0 {
+		return "", invalid
+	}
+	parsed, err := url.Parse(link)
+	if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.User != nil {
+		return "", invalid
+	}
+	// A host without a dot, such as "localhost", is not reachable by visitors
+	host := parsed.Hostname()
+	if host == "" || !strings.Contains(strings.Trim(host, "."), ".") {
+		return "", invalid
+	}
+	return link, nil
+}
+
+// normalizeProjectLinks validates the demo and source links of a project.
+func normalizeProjectLinks(project *domain.Project) error {
+	var err error
+	if project.DemoLink, err = normalizeLink(domain.LinkFieldDemo, project.DemoLink); err != nil {
+		return err
+	}
+	project.SourceLink, err = normalizeLink(domain.LinkFieldSource, project.SourceLink)
+	return err
+}
+
+// normalizeProfileLinks validates the social links of a profile.
+func normalizeProfileLinks(profile *domain.Profile) error {
+	var err error
+	if profile.SocialGithub, err = normalizeLink(domain.LinkFieldGithub, profile.SocialGithub); err != nil {
+		return err
+	}
+	if profile.SocialLinkedin, err = normalizeLink(domain.LinkFieldLinkedin, profile.SocialLinkedin); err != nil {
+		return err
+	}
+	profile.SocialWebsite, err = normalizeLink(domain.LinkFieldWebsite, profile.SocialWebsite)
+	return err
+}
@@ -0,0 +1,14 @@
+package application
+
+import "strings"
+
+// MediaStore stores the images users upload, under names such as "projects/<uuid>.png".
+type MediaStore interface {
+	DeleteMedia(name string) error
+}
+
+// isUploadedMedia reports whether an image name refers to an upload rather than to one of the
+// default images shared by every project or profile without one.
+func isUploadedMedia(name string) bool {
+	return strings.HasPrefix(name, "projects/") || strings.HasPrefix(name, "profiles/")
+}
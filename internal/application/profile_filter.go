@@ -0,0 +1,78 @@
+package application
+
+import (
+	"devsearch-go/internal/domain"
+
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Profile search sort orders. ProfileSortDefault lists profiles in registration order.
+const (
+	ProfileSortDefault      = ""
+	ProfileSortEndorsements = "endorsements"
+)
+
+// ProfileFilter narrows down and orders the developer search. Empty fields do not filter.
+type ProfileFilter struct {
+	Query           string
+	SortBy          string
+	Availability    string
+	WorkArrangement string
+	Role            string
+	TimeZone        string
+	// MaxSalary matches developers whose public minimum salary expectation does not exceed it.
+	MaxSalary      int
+	SalaryCurrency string
+	// UpdatedSince matches developers who joined or edited their profile after it.
+	UpdatedSince time.Time
+}
+
+// validateJobPreferences normalizes and checks job-seeking preferences.
+func validateJobPreferences(preferences *domain.JobPreferences) error {
+	if preferences.Availability != "" && !contains(domain.Availabilities, preferences.Availability) {
+		return fmt.Errorf("unknown availability %q", preferences.Availability)
+	}
+	if preferences.WorkArrangement != "" && !contains(domain.WorkArrangements, preferences.WorkArrangement) {
+		return fmt.Errorf("unknown work arrangement %q", preferences.WorkArrangement)
+	}
+
+	roles := preferences.PreferredRoleList()
+	preferences.PreferredRoles = strings.Join(roles, ", ")
+
+	if preferences.SalaryMin < 0 || preferences.SalaryMax < 0 {
+		return fmt.Errorf("salary expectation cannot be negative")
+	}
+	if preferences.SalaryMax > 0 && preferences.SalaryMin > preferences.SalaryMax {
+		return fmt.Errorf("minimum salary cannot be greater than maximum salary")
+	}
+	preferences.SalaryCurrency = strings.ToUpper(strings.TrimSpace(preferences.SalaryCurrency))
+	if preferences.SalaryCurrency != "" && len(preferences.SalaryCurrency) != 3 {
+		return fmt.Errorf("currency must be a three-letter code such as USD")
+	}
+	if preferences.SalaryVisibility == "" {
+		preferences.SalaryVisibility = domain.VisibilityHidden
+	}
+	if !contains(domain.Visibilities, preferences.SalaryVisibility) {
+		return fmt.Errorf("unknown salary visibility %q", preferences.SalaryVisibility)
+	}
+
+	preferences.TimeZone = strings.TrimSpace(preferences.TimeZone)
+	if preferences.TimeZone != "" {
+		if _, err := time.LoadLocation(preferences.TimeZone); err != nil {
+			return fmt.Errorf("unknown time zone %q", preferences.TimeZone)
+		}
+	}
+	return nil
+}
+
+// contains reports whether value is one of values.
+func contains(values []string, value string) bool {
+	for _, known := range values {
+		if known == value {
+			return true
+		}
+	}
+	return false
+}
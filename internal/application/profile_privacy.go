@@ -0,0 +1,109 @@
+package application
+
+import (
+	"devsearch-go/internal/domain"
+
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+// ErrProfileNotVisible is returned when a private profile is requested by someone other than its owner.
+var ErrProfileNotVisible = errors.New("profile is not visible")
+
+// canView reports whether a viewer may see a field with the given visibility on the owner's profile.
+// uuid.Nil identifies an anonymous visitor.
+func canView(visibility string, viewerID, ownerID uuid.UUID) bool {
+	if viewerID != uuid.Nil && viewerID == ownerID {
+		return true
+	}
+	switch visibility {
+	case domain.VisibilityPublic:
+		return true
+	case domain.VisibilityMembers:
+		return viewerID != uuid.Nil
+	}
+	return false
+}
+
+// applyProfilePrivacy clears the fields of a profile that the viewer may not see. It returns
+// ErrProfileNotVisible when the whole profile is private. uuid.Nil identifies an anonymous visitor.
+func applyProfilePrivacy(profile *domain.Profile, viewerID uuid.UUID) error {
+	privacy := profile.Privacy
+	if (privacy.Mode == domain.ProfileModePrivate || profile.IsHidden()) && viewerID != profile.UserID {
+		return ErrProfileNotVisible
+	}
+
+	if !canView(privacy.Email, viewerID, profile.UserID) {
+		profile.Email = ""
+	}
+	if !canView(privacy.Location, viewerID, profile.UserID) {
+		profile.Location = ""
+	}
+	if !canView(privacy.SocialLinks, viewerID, profile.UserID) {
+		profile.SocialGithub, profile.SocialLinkedin, profile.SocialWebsite = "", "", ""
+	}
+	if !canView(privacy.Experiences, viewerID, profile.UserID) {
+		profile.Experiences = nil
+	}
+	if !canView(privacy.Educations, viewerID, profile.UserID) {
+		profile.Educations = nil
+	}
+	if viewerID != profile.UserID {
+		visible := profile.Projects[:0]
+		for _, project := range profile.Projects {
+			if project.IsPublished() && !project.IsHidden() {
+				visible = append(visible, project)
+			}
+		}
+		profile.Projects = visible
+	}
+
+	preferences := &profile.JobPreferences
+	if !canView(preferences.SalaryVisibility, viewerID, profile.UserID) {
+		preferences.SalaryMin, preferences.SalaryMax, preferences.SalaryCurrency = 0, 0, ""
+	}
+
+	for i := range profile.Skills {
+		for j := range profile.Skills[i].Endorsements {
+			endorser := &profile.Skills[i].Endorsements[j].Endorser
+			if err := applyProfilePrivacy(endorser, viewerID); err != nil {
+				// Keep the endorsement counted without revealing who a private developer is.
+				*endorser = domain.Profile{ID: endorser.ID, UserID: endorser.UserID}
+			}
+		}
+	}
+	return nil
+}
+
+// validateProfilePrivacy checks privacy settings, filling in the defaults for empty ones.
+func validateProfilePrivacy(privacy *domain.ProfilePrivacy) error {
+	if privacy.Mode == "" {
+		privacy.Mode = domain.ProfileModePublic
+	}
+	if !contains(domain.ProfileModes, privacy.Mode) {
+		return fmt.Errorf("unknown profile mode %q", privacy.Mode)
+	}
+
+	fields := []struct {
+		name       string
+		visibility *string
+		fallback   string
+	}{
+		{"email", &privacy.Email, domain.VisibilityHidden},
+		{"location", &privacy.Location, domain.VisibilityPublic},
+		{"social links", &privacy.SocialLinks, domain.VisibilityPublic},
+		{"experience", &privacy.Experiences, domain.VisibilityPublic},
+		{"education", &privacy.Educations, domain.VisibilityPublic},
+	}
+	for _, field := range fields {
+		if *field.visibility == "" {
+			*field.visibility = field.fallback
+		}
+		if !contains(domain.Visibilities, *field.visibility) {
+			return fmt.Errorf("unknown %s visibility %q", field.name, *field.visibility)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,241 @@
+package application
+
+import (
+	"devsearch-go/internal/domain"
+
+	"encoding/json"
+	"io"
+	"net/url"
+	"path"
+	"regexp"
+	"sort"
+	"strings"
+	"unicode/utf8"
+)
+
+// Limits on what an import copies into the prefilled project.
+const (
+	maxImportedDescriptionLength = 5000
+	maxImportedLanguageTags      = 3
+	maxImportedTopicTags         = 7
+	// minImportedLanguageShare is the share of the code, in percent, a language needs to become a tag.
+	minImportedLanguageShare = 5
+)
+
+// languagesByExtension maps file extensions to the language they count towards.
+var languagesByExtension = map[string]string{
+	".go": "Go", ".py": "Python", ".js": "JavaScript", ".mjs": "JavaScript", ".jsx": "JavaScript",
+	".ts": "TypeScript", ".tsx": "TypeScript", ".java": "Java", ".kt": "Kotlin", ".kts": "Kotlin",
+	".scala": "Scala", ".rb": "Ruby", ".php": "PHP", ".rs": "Rust", ".c": "C", ".h": "C",
+	".cc": "C++", ".cpp": "C++", ".cxx": "C++", ".hpp": "C++", ".cs": "C#", ".swift": "Swift",
+	".m": "Objective-C", ".dart": "Dart", ".ex": "Elixir", ".exs": "Elixir", ".erl": "Erlang",
+	".hs": "Haskell", ".clj": "Clojure", ".lua": "Lua", ".r": "R", ".jl": "Julia", ".sh": "Shell",
+	".bash": "Shell", ".ps1": "PowerShell", ".sql": "SQL", ".html": "HTML", ".css": "CSS",
+	".scss": "SCSS", ".vue": "Vue", ".svelte": "Svelte",
+}
+
+// vendoredDirectories are left out of the language breakdown: their code was not written for the project.
+var vendoredDirectories = map[string]bool{
+	"node_modules": true, "vendor": true, "third_party": true, "dist": true, "build": true, ".git": true,
+}
+
+// tomlKeywords matches the keywords array of a Cargo.toml or pyproject.toml manifest.
+var tomlKeywords = regexp.MustCompile(`(?m)^\s*keywords\s*=\s*\[([^\]]*)\]`)
+
+// LanguageShare is the part of a repository's code written in one language.
+type LanguageShare struct {
+	Language string
+	Bytes    int64
+	Percent  int
+}
+
+// ProjectImport is an unsaved project prefilled from a repository, with what was found there.
+type ProjectImport struct {
+	Project     domain.Project
+	Languages   []LanguageShare // Largest first
+	Topics      []string
+	CommitCount int
+}
+
+// ProjectImportUseCase defines the business logic for prefilling projects from code repositories.
+type ProjectImportUseCase struct {
+	Reader RepositoryReader
+}
+
+// NewProjectImportUseCase creates a new ProjectImportUseCase.
+func NewProjectImportUseCase(reader RepositoryReader) *ProjectImportUseCase {
+	return &ProjectImportUseCase{Reader: reader}
+}
+
+// ImportArchive prefills a project from an uploaded zip or gzipped tar archive of a repository.
+func (uc *ProjectImportUseCase) ImportArchive(archive io.ReaderAt, size int64, filename string) (*ProjectImport, error) {
+	contents, err := uc.Reader.ReadArchive(archive, size, filename)
+	if err != nil {
+		return nil, err
+	}
+	return buildProjectImport(contents), nil
+}
+
+// ImportMirror prefills a project from a repository on the local git mirror, by its path
+// relative to the mirror.
+func (uc *ProjectImportUseCase) ImportMirror(path string) (*ProjectImport, error) {
+	contents, err := uc.Reader.ReadMirror(path)
+	if err != nil {
+		return nil, err
+	}
+	return buildProjectImport(contents), nil
+}
+
+// buildProjectImport turns the contents of a repository into a draft project: the README
+// becomes the description, and the main languages and the manifest keywords become tags.
+func buildProjectImport(contents *RepositoryContents) *ProjectImport {
+	result := &ProjectImport{
+		Languages:   languageBreakdown(contents.Files),
+		Topics:      repositoryTopics(contents.Metadata),
+		CommitCount: contents.CommitCount,
+	}
+
+	project := &result.Project
+	project.Title = contents.Name
+	project.Description = readmeDescription(repositoryReadme(contents.Metadata), contents.Name)
+	project.SourceLink = publicRemoteURL(contents.RemoteURL)
+	project.Status = domain.ProjectStatusDraft
+
+	seen := make(map[string]bool)
+	addTag := func(name string) {
+		if key := strings.ToLower(name); !seen[key] {
+			seen[key] = true
+			project.Tags = append(project.Tags, domain.Tag{Name: name})
+		}
+	}
+	for i, language := range result.Languages {
+		if i == maxImportedLanguageTags || language.Percent < minImportedLanguageShare {
+			break
+		}
+		addTag(language.Language)
+	}
+	for i, topic := range result.Topics {
+		if i == maxImportedTopicTags {
+			break
+		}
+		addTag(topic)
+	}
+	return result
+}
+
+// languageBreakdown sums the size of the files of each known language, outside vendored directories.
+func languageBreakdown(files []RepositoryFile) []LanguageShare {
+	bytesByLanguage := make(map[string]int64)
+	var total int64
+	for _, file := range files {
+		if isVendored(file.Path) {
+			continue
+		}
+		language, ok := languagesByExtension[strings.ToLower(path.Ext(file.Path))]
+		if !ok {
+			continue
+		}
+		bytesByLanguage[language] += file.Size
+		total += file.Size
+	}
+	if total == 0 {
+		return nil
+	}
+
+	shares := make([]LanguageShare, 0, len(bytesByLanguage))
+	for language, size := range bytesByLanguage {
+		shares = append(shares, LanguageShare{Language: language, Bytes: size, Percent: int(size * 100 / total)})
+	}
+	sort.Slice(shares, func(i, j int) bool {
+		if shares[i].Bytes != shares[j].Bytes {
+			return shares[i].Bytes > shares[j].Bytes
+		}
+		return shares[i].Language < shares[j].Language
+	})
+	return shares
+}
+
+// isVendored reports whether a file lies in a vendored directory or a hidden one.
+func isVendored(filePath string) bool {
+	dirs := strings.Split(path.Dir(filePath), "/")
+	for _, dir := range dirs {
+		if vendoredDirectories[dir] || (strings.HasPrefix(dir, ".") && dir != ".") {
+			return true
+		}
+	}
+	return false
+}
+
+// repositoryReadme returns the text of the preferred README of a repository.
+func repositoryReadme(metadata map[string]string) string {
+	for _, name := range RepositoryMetadataFiles {
+		if strings.HasPrefix(name, "README") && metadata[name] != "" {
+			return metadata[name]
+		}
+	}
+	return ""
+}
+
+// readmeDescription makes a project description out of a README, dropping a leading heading
+// that only repeats the repository name and cutting it to maxImportedDescriptionLength.
+func readmeDescription(readme, name string) string {
+	readme = strings.ToValidUTF8(strings.ReplaceAll(readme, "\x00", ""), "")
+	readme = strings.TrimSpace(strings.ReplaceAll(readme, "\r\n", "\n"))
+	first, rest, _ := strings.Cut(readme, "\n")
+	if strings.HasPrefix(first, "#") && strings.EqualFold(strings.TrimSpace(strings.TrimLeft(first, "#")), name) {
+		readme = strings.TrimSpace(rest)
+	}
+	if len(readme) <= maxImportedDescriptionLength {
+		return readme
+	}
+	cut := maxImportedDescriptionLength
+	for cut > 0 && !utf8.RuneStart(readme[cut]) {
+		cut--
+	}
+	return strings.TrimSpace(readme[:cut]) + "…"
+}
+
+// repositoryTopics collects the keywords of the package manifests of a repository, lower-cased
+// and without duplicates.
+func repositoryTopics(metadata map[string]string) []string {
+	var keywords []string
+	for _, name := range []string{"package.json", "composer.json"} {
+		var manifest struct {
+			Keywords []string `json:"keywords"`
+		}
+		if text := metadata[name]; text != "" && json.Unmarshal([]byte(text), &manifest) == nil {
+			keywords = append(keywords, manifest.Keywords...)
+		}
+	}
+	for _, name := range []string{"Cargo.toml", "pyproject.toml"} {
+		if match := tomlKeywords.FindStringSubmatch(metadata[name]); match != nil {
+			for _, keyword := range strings.Split(match[1], ",") {
+				keywords = append(keywords, strings.Trim(strings.TrimSpace(keyword), `"'`))
+			}
+		}
+	}
+
+	var topics []string
+	seen := make(map[string]bool)
+	for _, keyword := range keywords {
+		topic := strings.ToLower(strings.TrimSpace(keyword))
+		if topic == "" || seen[topic] || strings.Contains(topic, ",") {
+			continue
+		}
+		seen[topic] = true
+		topics = append(topics, topic)
+	}
+	return topics
+}
+
+// publicRemoteURL returns the web address of a git remote when it is served over HTTP, without
+// any credentials and the .git suffix, and "" otherwise.
+func publicRemoteURL(remote string) string {
+	parsed, err := url.Parse(strings.TrimSpace(remote))
+	if err != nil || (parsed.Scheme != "https" && parsed.Scheme != "http") || parsed.Host == "" {
+		return ""
+	}
+	parsed.User = nil
+	parsed.Path = strings.TrimSuffix(parsed.Path, ".git")
+	return parsed.String()
+}
@@ -3,6 +3,8 @@ package application
 import (
 	"devsearch-go/internal/domain"
 
+	"time"
+
 	"github.com/google/uuid"
 )
 
@@ -10,10 +12,19 @@ import (
 type ProjectRepository interface {
 	FindAllProjects(searchQuery string, page, limit int) ([]domain.Project, int64, error)
 	FindProjectByID(id uuid.UUID) (*domain.Project, error)
+	FindProjectBySlug(slug string) (*domain.Project, error)
+	FindProjectsWithoutSlug(limit int) ([]domain.Project, error)
+	UpdateProjectSlug(id uuid.UUID, slug string) error
+	FindScheduledProjects(publishBefore time.Time, limit int) ([]domain.Project, error)
+	PublishProject(id uuid.UUID) error
 	CreateProject(project *domain.Project) error
 	UpdateProject(project *domain.Project) error
 	DeleteProject(id uuid.UUID) error
 	FindOrCreateTag(tagName string) (*domain.Tag, error)
 	AssociateTagWithProject(project *domain.Project, tag *domain.Tag) error
 	ClearProjectTags(project *domain.Project) error
+	FindReview(projectID, ownerID uuid.UUID) (*domain.Review, error)
+	CreateReview(review *domain.Review) error
+	CountReviewVotes(projectID uuid.UUID) (total, up int64, err error)
+	UpdateProjectVotes(id uuid.UUID, voteTotal, voteRatio int) error
 }
@@ -0,0 +1,155 @@
+package application
+
+import (
+	"devsearch-go/internal/domain"
+
+	"errors"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+// ErrRevisionNotFound is returned for revisions that do not exist or belong to another project.
+var ErrRevisionNotFound = errors.New("revision not found")
+
+// revisionFieldLabels names the fields compared between revisions, in the order revisionValues
+// returns them.
+var revisionFieldLabels = []string{"Title", "Slug", "Description", "Demo link", "Source link", "Featured image", "Tags"}
+
+// ProjectRevisionEntry is a revision of a project with the fields it changed compared to the
+// revision before it. The first revision lists every field that has a value.
+type ProjectRevisionEntry struct {
+	Revision domain.ProjectRevision
+	Changes  []domain.ProjectRevisionChange
+}
+
+// GetProjectHistory retrieves the revisions of a project, newest first, with what each changed.
+func (uc *ProjectUseCase) GetProjectHistory(projectID uuid.UUID) ([]ProjectRevisionEntry, error) {
+	revisions, err := uc.RevisionRepo.FindProjectRevisions(projectID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load project history: %w", err)
+	}
+
+	entries := make([]ProjectRevisionEntry, len(revisions))
+	for i := range revisions {
+		var previous *domain.ProjectRevision
+		if i+1 < len(revisions) {
+			previous = &revisions[i+1]
+		}
+		entries[i] = ProjectRevisionEntry{Revision: revisions[i], Changes: revisionChanges(previous, &revisions[i])}
+	}
+	return entries, nil
+}
+
+// RevertProject restores the title, slug, description, links, featured image and tags of an
+// earlier revision of a project the user owns. The revert is recorded as a new revision, so it
+// can be undone the same way.
+func (uc *ProjectUseCase) RevertProject(projectID, revisionID, userID uuid.UUID, meta RequestMeta) (*domain.Project, error) {
+	project, err := authorizeProject(uc.ProjectRepo, projectID, userID, ActionRevert)
+	if err != nil {
+		return project, err
+	}
+	revision, err := uc.RevisionRepo.FindProjectRevisionByID(revisionID)
+	if err != nil || revision.ProjectID != project.ID {
+		return project, ErrRevisionNotFound
+	}
+
+	data := &domain.Project{
+		Title:         revision.Title,
+		Slug:          revision.Slug,
+		Description:   revision.Description,
+		FeaturedImage: revision.FeaturedImage,
+		DemoLink:      revision.DemoLink,
+		SourceLink:    revision.SourceLink,
+	}
+	if err := uc.applyProjectUpdate(project, userID, data, revision.TagNames(), revision.Number, meta); err != nil {
+		return project, err
+	}
+	return project, nil
+}
+
+// recordProjectRevision records the state of a project after the user created or changed it.
+// Projects last changed before revisions were kept first get their previous state as a
+// baseline, and edits that changed none of the recorded fields are skipped. Like the activity
+// log it never fails the change itself, but a lost revision is logged.
+func (uc *ProjectUseCase) recordProjectRevision(before, project *domain.Project, userID uuid.UUID, revertedFrom int) {
+	revision := projectSnapshot(project)
+	revision.AuthorID = &userID
+	if author, err := uc.ProfileRepo.FindProfileByUserID(userID); err == nil {
+		revision.AuthorName = author.Username
+	}
+	revision.RevertedFrom = revertedFrom
+
+	latest, err := uc.RevisionRepo.FindLatestProjectRevision(project.ID)
+	switch {
+	case err == nil:
+		if revertedFrom == 0 && len(revisionChanges(latest, &revision)) == 0 {
+			return
+		}
+		revision.Number = latest.Number + 1
+	case before != nil:
+		baseline := projectSnapshot(before)
+		baseline.Number = 1
+		if err := uc.RevisionRepo.CreateProjectRevision(&baseline); err != nil {
+			log.Printf("Failed to record baseline revision of project %s: %v", project.ID, err)
+		}
+		revision.Number = 2
+	default:
+		revision.Number = 1
+	}
+
+	if err := uc.RevisionRepo.CreateProjectRevision(&revision); err != nil {
+		log.Printf("Failed to record revision of project %s: %v", project.ID, err)
+	}
+}
+
+// projectSnapshot copies the fields of a project that revisions keep.
+func projectSnapshot(project *domain.Project) domain.ProjectRevision {
+	tags := make([]string, 0, len(project.Tags))
+	for _, tag := range project.Tags {
+		tags = append(tags, tag.Name)
+	}
+	sort.Strings(tags)
+	return domain.ProjectRevision{
+		ProjectID:     project.ID,
+		Title:         project.Title,
+		Slug:          project.Slug,
+		Description:   project.Description,
+		FeaturedImage: project.FeaturedImage,
+		DemoLink:      project.DemoLink,
+		SourceLink:    project.SourceLink,
+		Tags:          strings.Join(tags, ","),
+	}
+}
+
+// revisionChanges lists the fields that differ between two revisions. before is nil for the
+// first revision, which lists every field that has a value.
+func revisionChanges(before, after *domain.ProjectRevision) []domain.ProjectRevisionChange {
+	beforeValues, afterValues := revisionValues(before), revisionValues(after)
+	var changes []domain.ProjectRevisionChange
+	for i, field := range revisionFieldLabels {
+		if beforeValues[i] != afterValues[i] {
+			changes = append(changes, domain.ProjectRevisionChange{Field: field, Before: beforeValues[i], After: afterValues[i]})
+		}
+	}
+	return changes
+}
+
+// revisionValues returns the compared fields of a revision in the order of revisionFieldLabels.
+func revisionValues(revision *domain.ProjectRevision) []string {
+	if revision == nil {
+		return make([]string, len(revisionFieldLabels))
+	}
+	return []string{
+		revision.Title,
+		revision.Slug,
+		revision.Description,
+		revision.DemoLink,
+		revision.SourceLink,
+		revision.FeaturedImage,
+		strings.Join(revision.TagNames(), ", "),
+	}
+}
@@ -0,0 +1,15 @@
+package application
+
+import (
+	"devsearch-go/internal/domain"
+
+	"github.com/google/uuid"
+)
+
+// ProjectRevisionRepository defines the interface for data operations on project revisions.
+type ProjectRevisionRepository interface {
+	FindProjectRevisions(projectID uuid.UUID) ([]domain.ProjectRevision, error)
+	FindProjectRevisionByID(id uuid.UUID) (*domain.ProjectRevision, error)
+	FindLatestProjectRevision(projectID uuid.UUID) (*domain.ProjectRevision, error)
+	CreateProjectRevision(revision *domain.ProjectRevision) error
+}
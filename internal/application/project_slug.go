@@ -0,0 +1,119 @@
+package application
+
+import (
+	"devsearch-go/internal/domain"
+
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+// maxSlugAttempts bounds the numbered suffixes tried to make a slug unique.
+const maxSlugAttempts = 100
+
+// slugBackfillBatchSize is the number of projects given a slug per query.
+const slugBackfillBatchSize = 100
+
+// maxSlugSaveAttempts bounds the saves retried when another project takes the chosen slug first.
+const maxSlugSaveAttempts = 5
+
+// ErrProjectSlugTaken is returned by the project repository when another project outside the
+// trash already uses the slug being saved.
+var ErrProjectSlugTaken = errors.New("project slug is already taken")
+
+// GetProjectBySlug retrieves a project by its current slug or by a slug it had before a rename.
+// moved reports that the slug is an old one and callers should redirect to the project's URL.
+func (uc *ProjectUseCase) GetProjectBySlug(slug string) (project *domain.Project, moved bool, err error) {
+	slug = strings.ToLower(slug)
+	if project, err = uc.ProjectRepo.FindProjectBySlug(slug); err == nil {
+		return project, false, nil
+	}
+
+	redirect, err := uc.SlugRepo.FindSlugRedirect(domain.SlugKindProject, slug)
+	if err != nil {
+		return nil, false, fmt.Errorf("project not found: %w", err)
+	}
+	project, err = uc.ProjectRepo.FindProjectByID(redirect.TargetID)
+	if err != nil {
+		return nil, false, fmt.Errorf("project not found: %w", err)
+	}
+	return project, true, nil
+}
+
+// BackfillProjectSlugs gives a slug to every project created before projects had slugs.
+// It returns the number of projects updated.
+func (uc *ProjectUseCase) BackfillProjectSlugs() (int, error) {
+	updated := 0
+	for {
+		projects, err := uc.ProjectRepo.FindProjectsWithoutSlug(slugBackfillBatchSize)
+		if err != nil {
+			return updated, fmt.Errorf("failed to load projects without slug: %w", err)
+		}
+		if len(projects) == 0 {
+			return updated, nil
+		}
+		for i := range projects {
+			project := &projects[i]
+			if err := uc.assignProjectSlug(project); err != nil {
+				return updated, err
+			}
+			if err := uc.ProjectRepo.UpdateProjectSlug(project.ID, project.Slug); err != nil {
+				return updated, fmt.Errorf("failed to save slug of project %s: %w", project.ID, err)
+			}
+			updated++
+		}
+	}
+}
+
+// assignProjectSlug sets a unique slug on the project. The slug requested in project.Slug is kept
+// when set, otherwise one is derived from the title. Clashes get a numbered suffix.
+func (uc *ProjectUseCase) assignProjectSlug(project *domain.Project) error {
+	base := domain.Slugify(project.Slug)
+	if base == "" {
+		base = domain.Slugify(project.Title)
+	}
+	if base == "" {
+		base = "project"
+	}
+
+	for attempt := 1; attempt <= maxSlugAttempts; attempt++ {
+		slug := base
+		if attempt > 1 {
+			slug = fmt.Sprintf("%s-%d", base, attempt)
+		}
+		if !uc.projectSlugTaken(slug, project.ID) {
+			project.Slug = slug
+			return nil
+		}
+	}
+	return fmt.Errorf("could not find a free slug for %q", base)
+}
+
+// saveProjectWithSlug assigns a free slug to the project and saves it. When another project
+// takes the slug between the check and the save, the next free slug is tried.
+func (uc *ProjectUseCase) saveProjectWithSlug(project *domain.Project, save func(*domain.Project) error) error {
+	requested := project.Slug
+	for attempt := 1; ; attempt++ {
+		project.Slug = requested
+		if err := uc.assignProjectSlug(project); err != nil {
+			return err
+		}
+		err := save(project)
+		if !errors.Is(err, ErrProjectSlugTaken) || attempt == maxSlugSaveAttempts {
+			return err
+		}
+	}
+}
+
+// projectSlugTaken reports whether a slug is used, or was used before a rename, by another project.
+func (uc *ProjectUseCase) projectSlugTaken(slug string, projectID uuid.UUID) bool {
+	if existing, err := uc.ProjectRepo.FindProjectBySlug(slug); err == nil && existing.ID != projectID {
+		return true
+	}
+	if redirect, err := uc.SlugRepo.FindSlugRedirect(domain.SlugKindProject, slug); err == nil && redirect.TargetID != projectID {
+		return true
+	}
+	return false
+}
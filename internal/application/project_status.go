@@ -0,0 +1,66 @@
+package application
+
+import (
+	"devsearch-go/internal/domain"
+
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// scheduledPublishBatchSize is the number of scheduled drafts published per query.
+const scheduledPublishBatchSize = 100
+
+var (
+	// ErrInvalidProjectStatus is returned for a status other than draft, published or archived.
+	ErrInvalidProjectStatus = errors.New("status must be draft, published or archived")
+	// ErrPublishTimeInPast is returned when a draft is scheduled for a time that has passed.
+	ErrPublishTimeInPast = errors.New("the publication time must be in the future")
+)
+
+// prepareProjectStatus validates the requested status of a project. An empty status publishes
+// the project straight away. Only drafts keep a scheduled publication time.
+func prepareProjectStatus(project *domain.Project) error {
+	if project.Status == "" {
+		project.Status = domain.ProjectStatusPublished
+	}
+	if !contains(domain.ProjectStatuses, project.Status) {
+		return ErrInvalidProjectStatus
+	}
+	if project.Status != domain.ProjectStatusDraft {
+		project.PublishAt = nil
+		return nil
+	}
+	if project.PublishAt != nil && !project.PublishAt.After(time.Now()) {
+		return ErrPublishTimeInPast
+	}
+	return nil
+}
+
+// PublishScheduledProjects publishes the drafts whose scheduled publication time has come,
+// records the publication in the audit log without an actor, and announces the projects in
+// their owners' activity logs. It is run by a background job.
+func (uc *ProjectUseCase) PublishScheduledProjects() error {
+	for {
+		projects, err := uc.ProjectRepo.FindScheduledProjects(time.Now(), scheduledPublishBatchSize)
+		if err != nil {
+			return fmt.Errorf("failed to load scheduled projects: %w", err)
+		}
+		for i := range projects {
+			project := &projects[i]
+			if err := uc.ProjectRepo.PublishProject(project.ID); err != nil {
+				return fmt.Errorf("failed to publish project %s: %w", project.ID, err)
+			}
+			before := *project
+			project.Status = domain.ProjectStatusPublished
+			project.PublishAt = nil
+			uc.recordProjectAudit(domain.AuditActionProjectPublish, uuid.Nil, project, auditChanges(&before, project), RequestMeta{})
+			uc.recordProjectActivity(domain.ActivityProjectCreated, project, "")
+		}
+		if len(projects) < scheduledPublishBatchSize {
+			return nil
+		}
+	}
+}
@@ -3,18 +3,41 @@ package application
 import (
 	"devsearch-go/internal/domain"
 
+	"errors"
+	"fmt"
+	"strings"
+
 	"github.com/google/uuid"
 )
 
+var (
+	// ErrOwnProjectReview is returned when a developer tries to review their own project.
+	ErrOwnProjectReview = errors.New("you cannot review your own work")
+	// ErrAlreadyReviewed is returned when the user already reviewed the project.
+	ErrAlreadyReviewed = errors.New("you have already submitted your review for this project")
+	// ErrInvalidVote is returned for a vote value other than up or down.
+	ErrInvalidVote = errors.New("vote must be up or down")
+)
+
 // ProjectUseCase defines the business logic for projects.
 type ProjectUseCase struct {
-	ProjectRepo ProjectRepository
+	ProjectRepo  ProjectRepository
+	SlugRepo     SlugRedirectRepository
+	ProfileRepo  ProfileRepository
+	ActivityRepo ActivityRepository
+	AuditRepo    AuditRepository
+	RevisionRepo ProjectRevisionRepository
 }
 
 // NewProjectUseCase creates a new ProjectUseCase.
-func NewProjectUseCase(projectRepo ProjectRepository) *ProjectUseCase {
+func NewProjectUseCase(projectRepo ProjectRepository, slugRepo SlugRedirectRepository, profileRepo ProfileRepository, activityRepo ActivityRepository, auditRepo AuditRepository, revisionRepo ProjectRevisionRepository) *ProjectUseCase {
 	return &ProjectUseCase{
-		ProjectRepo: projectRepo,
+		ProjectRepo:  projectRepo,
+		SlugRepo:     slugRepo,
+		ProfileRepo:  profileRepo,
+		ActivityRepo: activityRepo,
+		AuditRepo:    auditRepo,
+		RevisionRepo: revisionRepo,
 	}
 }
 
@@ -28,9 +51,24 @@ func (uc *ProjectUseCase) GetProjectByID(id uuid.UUID) (*domain.Project, error)
 	return uc.ProjectRepo.FindProjectByID(id)
 }
 
-// CreateProject creates a new project, handling tags.
-func (uc *ProjectUseCase) CreateProject(project *domain.Project, tagNames []string) error {
-	if err := uc.ProjectRepo.CreateProject(project); err != nil {
+// AuthorizeProject loads a project for a user who wants to perform an action on it. It returns
+// ErrProjectNotFound or ErrPermissionDenied when the user may not.
+func (uc *ProjectUseCase) AuthorizeProject(projectID, userID uuid.UUID, action Action) (*domain.Project, error) {
+	return authorizeProject(uc.ProjectRepo, projectID, userID, action)
+}
+
+// CreateProject creates a new project owned by the user, handling tags. The slug requested in
+// project.Slug is made unique, or derived from the title when empty. Projects without a status
+// are published straight away.
+func (uc *ProjectUseCase) CreateProject(userID uuid.UUID, project *domain.Project, tagNames []string, meta RequestMeta) error {
+	project.OwnerID = userID
+	if err := normalizeProjectLinks(project); err != nil {
+		return err
+	}
+	if err := prepareProjectStatus(project); err != nil {
+		return err
+	}
+	if err := uc.saveProjectWithSlug(project, uc.ProjectRepo.CreateProject); err != nil {
 		return err
 	}
 
@@ -46,20 +84,71 @@ func (uc *ProjectUseCase) CreateProject(project *domain.Project, tagNames []stri
 			continue
 		}
 	}
+
+	uc.recordProjectRevision(nil, project, userID, 0)
+	if project.IsPublished() {
+		uc.recordProjectActivity(domain.ActivityProjectCreated, project, "")
+	}
+	uc.recordProjectAudit(domain.AuditActionProjectCreate, userID, project, auditChanges(nil, project), meta)
 	return nil
 }
 
-// UpdateProject updates an existing project, handling tags.
-func (uc *ProjectUseCase) UpdateProject(project *domain.Project, tagNames []string) error {
+// UpdateProject updates a project the user may edit with the fields of data, handling tags. The
+// featured image is only replaced when data has one, and the status when data has one. When the
+// slug changes the old one keeps redirecting to the project. Every update is recorded as a revision.
+func (uc *ProjectUseCase) UpdateProject(projectID, userID uuid.UUID, data *domain.Project, tagNames []string, meta RequestMeta) (*domain.Project, error) {
+	project, err := authorizeProject(uc.ProjectRepo, projectID, userID, ActionEdit)
+	if err != nil {
+		return nil, err
+	}
+	if err := normalizeProjectLinks(data); err != nil {
+		return nil, err
+	}
+	if err := uc.applyProjectUpdate(project, userID, data, tagNames, 0, meta); err != nil {
+		return nil, err
+	}
+	return project, nil
+}
+
+// applyProjectUpdate copies the fields of data and the tags onto a project and saves it, then
+// records the revision, activity and audit event. revertedFrom is the number of the revision
+// being restored, or 0 for an ordinary edit.
+func (uc *ProjectUseCase) applyProjectUpdate(project *domain.Project, userID uuid.UUID, data *domain.Project, tagNames []string, revertedFrom int, meta RequestMeta) error {
+	before := *project
+	oldSlug := project.Slug
+
+	project.Title = data.Title
+	project.Slug = data.Slug
+	project.Description = data.Description
+	project.DemoLink = data.DemoLink
+	project.SourceLink = data.SourceLink
+	if data.FeaturedImage != "" {
+		project.FeaturedImage = data.FeaturedImage
+	}
+	if data.Status != "" {
+		project.Status = data.Status
+		project.PublishAt = data.PublishAt
+		if err := prepareProjectStatus(project); err != nil {
+			return err
+		}
+	}
+
 	// Clear existing tags
 	if err := uc.ProjectRepo.ClearProjectTags(project); err != nil {
 		// Log error but continue with project update
 	}
 
-	if err := uc.ProjectRepo.UpdateProject(project); err != nil {
+	if err := uc.saveProjectWithSlug(project, uc.ProjectRepo.UpdateProject); err != nil {
 		return err
 	}
 
+	if oldSlug != "" && oldSlug != project.Slug {
+		redirect := domain.SlugRedirect{Kind: domain.SlugKindProject, Slug: oldSlug, TargetID: project.ID}
+		if err := uc.SlugRepo.SaveSlugRedirect(&redirect); err != nil {
+			return fmt.Errorf("failed to keep old project URL: %w", err)
+		}
+	}
+
 	// Add new tags
 	for _, tagName := range tagNames {
 		tag, err := uc.ProjectRepo.FindOrCreateTag(tagName)
@@ -72,10 +161,114 @@ func (uc *ProjectUseCase) UpdateProject(project *domain.Project, tagNames []stri
 			continue
 		}
 	}
+
+	uc.recordProjectRevision(&before, project, userID, revertedFrom)
+	switch {
+	case project.IsPublished() && before.Status == domain.ProjectStatusDraft:
+		uc.recordProjectActivity(domain.ActivityProjectCreated, project, "")
+	case project.IsPublished():
+		uc.recordProjectActivity(domain.ActivityProjectUpdated, project, "")
+	}
+	action := domain.AuditActionProjectUpdate
+	if revertedFrom > 0 {
+		action = domain.AuditActionProjectRevert
+	}
+	uc.recordProjectAudit(action, userID, project, auditChanges(&before, project), meta)
+	return nil
+}
+
+// AddReview records the user's review of another developer's project and updates its vote counts.
+// It returns the project so callers can link back to it; the project is nil only when it could not be loaded.
+func (uc *ProjectUseCase) AddReview(projectID, userID uuid.UUID, body, value string) (*domain.Project, error) {
+	project, err := uc.ProjectRepo.FindProjectByID(projectID)
+	if err != nil {
+		return nil, fmt.Errorf("project not found: %w", err)
+	}
+	if !CanSeeProject(Principal{UserID: userID}, project) {
+		return nil, ErrProjectNotFound
+	}
+	if value != domain.VoteUp && value != domain.VoteDown {
+		return project, ErrInvalidVote
+	}
+	if project.OwnerID == userID {
+		return project, ErrOwnProjectReview
+	}
+	if _, err := uc.ProjectRepo.FindReview(project.ID, userID); err == nil {
+		return project, ErrAlreadyReviewed
+	}
+
+	review := domain.Review{ProjectID: project.ID, OwnerID: userID, Body: strings.TrimSpace(body), Value: value}
+	if err := uc.ProjectRepo.CreateReview(&review); err != nil {
+		return project, fmt.Errorf("failed to create review: %w", err)
+	}
+
+	if err := recountProjectVotes(uc.ProjectRepo, project); err != nil {
+		return project, err
+	}
+
+	detail := value + " vote"
+	if reviewer, err := uc.ProfileRepo.FindProfileByUserID(userID); err == nil && reviewer.Privacy.Mode != domain.ProfileModePrivate {
+		detail += " from " + reviewer.Name
+	}
+	uc.recordProjectActivity(domain.ActivityReviewReceived, project, detail)
+	return project, nil
+}
+
+// recountProjectVotes recomputes the vote total and positive ratio of a project from its reviews.
+func recountProjectVotes(projectRepo ProjectRepository, project *domain.Project) error {
+	total, up, err := projectRepo.CountReviewVotes(project.ID)
+	if err != nil {
+		return fmt.Errorf("failed to count votes: %w", err)
+	}
+	project.VoteTotal = int(total)
+	project.VoteRatio = 0
+	if total > 0 {
+		project.VoteRatio = int(up * 100 / total)
+	}
+	if err := projectRepo.UpdateProjectVotes(project.ID, project.VoteTotal, project.VoteRatio); err != nil {
+		return fmt.Errorf("failed to update votes: %w", err)
+	}
+	return nil
+}
+
+// recordProjectActivity logs an event about a project in its owner's activity log.
+func (uc *ProjectUseCase) recordProjectActivity(kind string, project *domain.Project, detail string) {
+	owner, err := uc.ProfileRepo.FindProfileByUserID(project.OwnerID)
+	if err != nil {
+		return
+	}
+	recordActivity(uc.ActivityRepo, &domain.ActivityEvent{
+		Kind:      kind,
+		ActorID:   owner.ID,
+		SubjectID: project.ID,
+		Title:     project.Title,
+		Detail:    detail,
+		URL:       project.URL(),
+	})
+}
+
+// DeleteProject moves a project the user may delete to the trash, from which its owner can
+// restore it within TrashRetention.
+func (uc *ProjectUseCase) DeleteProject(projectID, userID uuid.UUID, meta RequestMeta) error {
+	project, err := authorizeProject(uc.ProjectRepo, projectID, userID, ActionDelete)
+	if err != nil {
+		return err
+	}
+	if err := uc.ProjectRepo.DeleteProject(project.ID); err != nil {
+		return err
+	}
+	uc.recordProjectAudit(domain.AuditActionProjectDelete, userID, project, auditChanges(project, nil), meta)
 	return nil
 }
 
-// DeleteProject deletes a project by its ID.
-func (uc *ProjectUseCase) DeleteProject(id uuid.UUID) error {
-	return uc.ProjectRepo.DeleteProject(id)
+// recordProjectAudit records an action of the user on a project in the audit log.
+func (uc *ProjectUseCase) recordProjectAudit(action string, userID uuid.UUID, project *domain.Project, changes string, meta RequestMeta) {
+	recordAudit(uc.AuditRepo, meta, &domain.AuditEvent{
+		ActorID:     auditActor(userID),
+		Action:      action,
+		TargetKind:  domain.AuditTargetProject,
+		TargetID:    &project.ID,
+		TargetLabel: project.Title,
+		Changes:     changes,
+	})
 }
@@ -0,0 +1,18 @@
+package application
+
+import (
+	"devsearch-go/internal/domain"
+
+	"github.com/google/uuid"
+)
+
+// RecommendationRepository defines the interface for data operations on precomputed similar
+// profiles and related projects.
+type RecommendationRepository interface {
+	FindProfilesForSimilarity() ([]domain.Profile, error)
+	FindProjectsForSimilarity() ([]domain.Project, error)
+	ReplaceSimilarProfiles(similar []domain.SimilarProfile) error
+	ReplaceRelatedProjects(related []domain.RelatedProject) error
+	FindSimilarProfiles(profileID uuid.UUID, limit int) ([]domain.SimilarProfile, error)
+	FindRelatedProjects(projectID uuid.UUID, limit int) ([]domain.RelatedProject, error)
+}
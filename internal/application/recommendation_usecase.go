@@ -0,0 +1,108 @@
+package application
+
+import (
+	"devsearch-go/internal/domain"
+
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// DefaultRecommendationLimit is the number of similar developers or related projects shown on a page.
+	DefaultRecommendationLimit = 5
+	// storedNeighborCount is the number of neighbours precomputed for every profile and project.
+	storedNeighborCount = 10
+)
+
+// RecommendationUseCase defines the business logic for similar developer and related project recommendations.
+type RecommendationUseCase struct {
+	RecommendationRepo RecommendationRepository
+}
+
+// NewRecommendationUseCase creates a new RecommendationUseCase.
+func NewRecommendationUseCase(recommendationRepo RecommendationRepository) *RecommendationUseCase {
+	return &RecommendationUseCase{
+		RecommendationRepo: recommendationRepo,
+	}
+}
+
+// RefreshRecommendations recomputes the nearest neighbours of every public profile and every project.
+// It is meant to run as a background job; both kinds are refreshed even when one of them fails.
+func (uc *RecommendationUseCase) RefreshRecommendations() error {
+	return errors.Join(uc.refreshSimilarProfiles(), uc.refreshRelatedProjects())
+}
+
+// GetSimilarProfiles retrieves the developers most similar to a profile, most similar first,
+// with the viewer's privacy restrictions applied. uuid.Nil identifies an anonymous visitor.
+func (uc *RecommendationUseCase) GetSimilarProfiles(profileID, viewerID uuid.UUID, limit int) ([]domain.SimilarProfile, error) {
+	similar, err := uc.RecommendationRepo.FindSimilarProfiles(profileID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load similar profiles: %w", err)
+	}
+	visible := similar[:0]
+	for _, entry := range similar {
+		if applyProfilePrivacy(&entry.Neighbor, viewerID) == nil {
+			visible = append(visible, entry)
+		}
+	}
+	return visible, nil
+}
+
+// GetRelatedProjects retrieves the projects most similar to a project, most similar first.
+func (uc *RecommendationUseCase) GetRelatedProjects(projectID uuid.UUID, limit int) ([]domain.RelatedProject, error) {
+	related, err := uc.RecommendationRepo.FindRelatedProjects(projectID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load related projects: %w", err)
+	}
+	return related, nil
+}
+
+// refreshSimilarProfiles recomputes and stores the similar profiles of every public profile.
+func (uc *RecommendationUseCase) refreshSimilarProfiles() error {
+	profiles, err := uc.RecommendationRepo.FindProfilesForSimilarity()
+	if err != nil {
+		return fmt.Errorf("failed to load profiles for similarity: %w", err)
+	}
+
+	items := make(map[uuid.UUID]featureVector, len(profiles))
+	for _, profile := range profiles {
+		items[profile.ID] = profileFeatures(profile)
+	}
+	var similar []domain.SimilarProfile
+	for profileID, neighbors := range nearestNeighbors(items, storedNeighborCount) {
+		for _, neighbor := range neighbors {
+			similar = append(similar, domain.SimilarProfile{ProfileID: profileID, NeighborID: neighbor.ID, Score: neighbor.Score})
+		}
+	}
+
+	if err := uc.RecommendationRepo.ReplaceSimilarProfiles(similar); err != nil {
+		return fmt.Errorf("failed to store similar profiles: %w", err)
+	}
+	return nil
+}
+
+// refreshRelatedProjects recomputes and stores the related projects of every project.
+func (uc *RecommendationUseCase) refreshRelatedProjects() error {
+	projects, err := uc.RecommendationRepo.FindProjectsForSimilarity()
+	if err != nil {
+		return fmt.Errorf("failed to load projects for similarity: %w", err)
+	}
+
+	items := make(map[uuid.UUID]featureVector, len(projects))
+	for _, project := range projects {
+		items[project.ID] = projectFeatures(project)
+	}
+	var related []domain.RelatedProject
+	for projectID, neighbors := range nearestNeighbors(items, storedNeighborCount) {
+		for _, neighbor := range neighbors {
+			related = append(related, domain.RelatedProject{ProjectID: projectID, NeighborID: neighbor.ID, Score: neighbor.Score})
+		}
+	}
+
+	if err := uc.RecommendationRepo.ReplaceRelatedProjects(related); err != nil {
+		return fmt.Errorf("failed to store related projects: %w", err)
+	}
+	return nil
+}
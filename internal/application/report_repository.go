@@ -0,0 +1,19 @@
+package application
+
+import (
+	"devsearch-go/internal/domain"
+
+	"github.com/google/uuid"
+)
+
+// ReportRepository defines the interface for content report and moderation case data operations.
+type ReportRepository interface {
+	FindReportCaseByTarget(kind string, targetID uuid.UUID) (*domain.ReportCase, error)
+	FindReportCaseByID(id uuid.UUID) (*domain.ReportCase, error)
+	FindReportCases(status string, page, limit int) ([]domain.ReportCase, int64, error)
+	CountReportCases(status string) (int64, error)
+	CreateReportCase(reportCase *domain.ReportCase) error
+	UpdateReportCase(reportCase *domain.ReportCase) error
+	FindReport(caseID, reporterID uuid.UUID) (*domain.Report, error)
+	CreateReport(report *domain.Report) error
+}
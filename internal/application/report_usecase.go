@@ -0,0 +1,275 @@
+package application
+
+import (
+	"devsearch-go/internal/domain"
+
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ReportAutoHideThreshold is the number of independent reports after which reported content
+// is hidden until a moderator resolves its case.
+const ReportAutoHideThreshold = 3
+
+// maxReportExcerptLength bounds the excerpt of the reported content copied into a case, in characters.
+const maxReportExcerptLength = 500
+
+var (
+	// ErrInvalidReportTarget is returned for a kind of content outside domain.ReportTargets.
+	ErrInvalidReportTarget = errors.New("this content cannot be reported")
+	// ErrInvalidReportReason is returned for a reason outside domain.ReportReasons.
+	ErrInvalidReportReason = errors.New("report reason must be spam, fake, abuse, inappropriate or other")
+	// ErrReportTargetNotFound is returned for content that does not exist or that the user cannot see.
+	ErrReportTargetNotFound = errors.New("reported content not found")
+	// ErrReportOwnContent is returned when a user reports their own content.
+	ErrReportOwnContent = errors.New("you cannot report your own content")
+	// ErrAlreadyReported is returned when the user already reported the content.
+	ErrAlreadyReported = errors.New("you have already reported this")
+	// ErrReportCaseNotFound is returned for moderation cases that do not exist.
+	ErrReportCaseNotFound = errors.New("report not found")
+	// ErrInvalidResolution is returned for a resolution other than actioned or dismissed.
+	ErrInvalidResolution = errors.New("resolution must be actioned or dismissed")
+	// ErrReportCaseResolved is returned when a moderator resolves a case that is not open.
+	ErrReportCaseResolved = errors.New("this report was already resolved")
+)
+
+// ReportUseCase defines the business logic for content reports and the moderation queue.
+type ReportUseCase struct {
+	ReportRepo  ReportRepository
+	AdminRepo   AdminRepository
+	UserRepo    UserRepository
+	ProjectRepo ProjectRepository
+	ProfileRepo ProfileRepository
+	MessageRepo MessageRepository
+	AuditRepo   AuditRepository
+}
+
+// NewReportUseCase creates a new ReportUseCase.
+func NewReportUseCase(reportRepo ReportRepository, adminRepo AdminRepository, userRepo UserRepository, projectRepo ProjectRepository, profileRepo ProfileRepository, messageRepo MessageRepository, auditRepo AuditRepository) *ReportUseCase {
+	return &ReportUseCase{
+		ReportRepo:  reportRepo,
+		AdminRepo:   adminRepo,
+		UserRepo:    userRepo,
+		ProjectRepo: projectRepo,
+		ProfileRepo: profileRepo,
+		MessageRepo: messageRepo,
+		AuditRepo:   auditRepo,
+	}
+}
+
+// reportTarget is what a report needs to know about the reported content.
+type reportTarget struct {
+	ownerID uuid.UUID // User who wrote the content, who cannot report it
+	title   string
+	url     string
+	excerpt string
+	hidden  bool
+}
+
+// ReportContent records the user's report about a project, profile, review or message. Reports
+// about the same content share a moderation case; new reports reopen a dismissed case. Once
+// ReportAutoHideThreshold users reported content in an open case, it is hidden until a
+// moderator resolves the case. Messages are only seen by their recipient and are never hidden.
+func (uc *ReportUseCase) ReportContent(userID uuid.UUID, kind string, targetID uuid.UUID, reason, details string) (*domain.ReportCase, error) {
+	if !contains(domain.ReportTargets, kind) {
+		return nil, ErrInvalidReportTarget
+	}
+	if !contains(domain.ReportReasons, reason) {
+		return nil, ErrInvalidReportReason
+	}
+	target, err := uc.loadReportTarget(userID, kind, targetID)
+	if err != nil {
+		return nil, err
+	}
+	if target.ownerID == userID {
+		return nil, ErrReportOwnContent
+	}
+
+	now := time.Now()
+	reportCase, err := uc.ReportRepo.FindReportCaseByTarget(kind, targetID)
+	if err != nil {
+		reportCase = &domain.ReportCase{
+			TargetKind:    kind,
+			TargetID:      targetID,
+			TargetTitle:   target.title,
+			TargetURL:     target.url,
+			TargetExcerpt: target.excerpt,
+			Status:        domain.ReportCaseOpen,
+			LastReportAt:  now,
+		}
+		if err := uc.ReportRepo.CreateReportCase(reportCase); err != nil {
+			return nil, fmt.Errorf("failed to open report case: %w", err)
+		}
+	} else if _, err := uc.ReportRepo.FindReport(reportCase.ID, userID); err == nil {
+		return nil, ErrAlreadyReported
+	}
+
+	report := domain.Report{CaseID: reportCase.ID, ReporterID: userID, Reason: reason, Details: strings.TrimSpace(details)}
+	if err := uc.ReportRepo.CreateReport(&report); err != nil {
+		return nil, fmt.Errorf("failed to create report: %w", err)
+	}
+
+	if reportCase.Status == domain.ReportCaseDismissed {
+		reportCase.Status = domain.ReportCaseOpen
+		reportCase.ReportCount = 0
+		reportCase.ResolvedAt, reportCase.ResolvedByID = nil, nil
+	}
+	reportCase.ReportCount++
+	reportCase.LastReportAt = now
+	if reportCase.Status == domain.ReportCaseOpen && reportCase.ReportCount >= ReportAutoHideThreshold &&
+		!target.hidden && kind != domain.ReportTargetMessage {
+		if err := uc.setTargetHidden(kind, targetID, &now); err != nil {
+			return nil, err
+		}
+		reportCase.AutoHiddenAt = &now
+	}
+	if err := uc.ReportRepo.UpdateReportCase(reportCase); err != nil {
+		return nil, fmt.Errorf("failed to update report case: %w", err)
+	}
+	return reportCase, nil
+}
+
+// GetReportCases retrieves the moderation cases in a state, for the moderation queue.
+func (uc *ReportUseCase) GetReportCases(actorID uuid.UUID, status string, page, limit int) ([]domain.ReportCase, int64, error) {
+	if _, err := loadStaffPrincipal(uc.UserRepo, actorID); err != nil {
+		return nil, 0, err
+	}
+	if !contains(domain.ReportCaseStatuses, status) {
+		status = domain.ReportCaseOpen
+	}
+	return uc.ReportRepo.FindReportCases(status, page, limit)
+}
+
+// CountOpenReportCases counts the cases waiting in the moderation queue.
+func (uc *ReportUseCase) CountOpenReportCases() (int64, error) {
+	return uc.ReportRepo.CountReportCases(domain.ReportCaseOpen)
+}
+
+// ResolveReportCase closes an open moderation case. Acting on it hides the reported content;
+// dismissing it shows content that was hidden automatically again.
+func (uc *ReportUseCase) ResolveReportCase(actorID, caseID uuid.UUID, resolution string, meta RequestMeta) (*domain.ReportCase, error) {
+	if resolution != domain.ReportCaseActioned && resolution != domain.ReportCaseDismissed {
+		return nil, ErrInvalidResolution
+	}
+	principal, err := loadStaffPrincipal(uc.UserRepo, actorID)
+	if err != nil {
+		return nil, err
+	}
+	reportCase, err := uc.ReportRepo.FindReportCaseByID(caseID)
+	if err != nil {
+		return nil, ErrReportCaseNotFound
+	}
+	if !Can(principal, ActionResolve, reportCase) {
+		return reportCase, ErrPermissionDenied
+	}
+	if reportCase.Status != domain.ReportCaseOpen {
+		return reportCase, ErrReportCaseResolved
+	}
+	before := *reportCase
+
+	now := time.Now()
+	switch {
+	case resolution == domain.ReportCaseActioned && reportCase.AutoHiddenAt == nil && reportCase.TargetKind != domain.ReportTargetMessage:
+		if err := uc.setTargetHidden(reportCase.TargetKind, reportCase.TargetID, &now); err != nil {
+			return reportCase, err
+		}
+	case resolution == domain.ReportCaseDismissed && reportCase.AutoHiddenAt != nil:
+		if err := uc.setTargetHidden(reportCase.TargetKind, reportCase.TargetID, nil); err != nil {
+			return reportCase, err
+		}
+		reportCase.AutoHiddenAt = nil
+	}
+
+	reportCase.Status = resolution
+	reportCase.ResolvedAt = &now
+	reportCase.ResolvedByID = &principal.UserID
+	if err := uc.ReportRepo.UpdateReportCase(reportCase); err != nil {
+		return reportCase, fmt.Errorf("failed to resolve report case: %w", err)
+	}
+	recordAudit(uc.AuditRepo, meta, &domain.AuditEvent{
+		ActorID:     auditActor(actorID),
+		Action:      domain.AuditActionReportResolve,
+		TargetKind:  domain.AuditTargetReportCase,
+		TargetID:    &reportCase.ID,
+		TargetLabel: reportCase.TargetKind + ": " + reportCase.TargetTitle,
+		Changes:     auditChanges(&before, reportCase),
+	})
+	return reportCase, nil
+}
+
+// loadReportTarget loads the reported content, treating content the user cannot see as missing.
+func (uc *ReportUseCase) loadReportTarget(userID uuid.UUID, kind string, targetID uuid.UUID) (*reportTarget, error) {
+	switch kind {
+	case domain.ReportTargetProject:
+		project, err := uc.ProjectRepo.FindProjectByID(targetID)
+		if err != nil || !CanSeeProject(Principal{UserID: userID}, project) {
+			return nil, ErrReportTargetNotFound
+		}
+		return &reportTarget{ownerID: project.OwnerID, title: project.Title, url: project.URL(),
+			excerpt: reportExcerpt(project.Description), hidden: project.IsHidden()}, nil
+	case domain.ReportTargetProfile:
+		profile, err := uc.ProfileRepo.FindProfileByID(targetID)
+		if err != nil || applyProfilePrivacy(profile, userID) != nil {
+			return nil, ErrReportTargetNotFound
+		}
+		return &reportTarget{ownerID: profile.UserID, title: profile.Name, url: profile.URL(),
+			excerpt: reportExcerpt(profile.ShortIntro + "\n" + profile.Bio), hidden: profile.IsHidden()}, nil
+	case domain.ReportTargetReview:
+		review, err := uc.AdminRepo.FindReviewByID(targetID)
+		if err != nil || review.IsHidden() || !CanSeeProject(Principal{UserID: userID}, &review.Project) {
+			return nil, ErrReportTargetNotFound
+		}
+		return &reportTarget{ownerID: review.OwnerID, title: "Review by " + review.Owner.Username + " of " + review.Project.Title,
+			url: review.Project.URL(), excerpt: reportExcerpt(review.Body), hidden: review.IsHidden()}, nil
+	case domain.ReportTargetMessage:
+		principal, err := loadPrincipal(uc.ProfileRepo, userID)
+		if err != nil {
+			return nil, err
+		}
+		message, err := uc.MessageRepo.FindMessageByID(targetID)
+		if err != nil || !Can(principal, ActionView, message) {
+			return nil, ErrReportTargetNotFound
+		}
+		// Only the recipient can report a message, and the recipient never wrote it.
+		return &reportTarget{title: message.Subject, excerpt: reportExcerpt("From " + message.Name + ": " + message.Body)}, nil
+	}
+	return nil, ErrInvalidReportTarget
+}
+
+// setTargetHidden hides reported content, or shows it again when hiddenAt is nil. Hiding or
+// showing a review changes the votes of its project.
+func (uc *ReportUseCase) setTargetHidden(kind string, targetID uuid.UUID, hiddenAt *time.Time) error {
+	switch kind {
+	case domain.ReportTargetProject:
+		if err := uc.AdminRepo.SetProjectHidden(targetID, hiddenAt); err != nil {
+			return fmt.Errorf("failed to update project: %w", err)
+		}
+	case domain.ReportTargetProfile:
+		if err := uc.AdminRepo.SetProfileHidden(targetID, hiddenAt); err != nil {
+			return fmt.Errorf("failed to update profile: %w", err)
+		}
+	case domain.ReportTargetReview:
+		if err := uc.AdminRepo.SetReviewHidden(targetID, hiddenAt); err != nil {
+			return fmt.Errorf("failed to update review: %w", err)
+		}
+		review, err := uc.AdminRepo.FindReviewByID(targetID)
+		if err != nil {
+			return fmt.Errorf("failed to load review: %w", err)
+		}
+		return recountProjectVotes(uc.ProjectRepo, &domain.Project{ID: review.ProjectID})
+	}
+	return nil
+}
+
+// reportExcerpt trims text to the length kept in a moderation case.
+func reportExcerpt(text string) string {
+	text = strings.TrimSpace(text)
+	if runes := []rune(text); len(runes) > maxReportExcerptLength {
+		return string(runes[:maxReportExcerptLength]) + "…"
+	}
+	return text
+}
@@ -0,0 +1,44 @@
+package application
+
+import (
+	"errors"
+	"io"
+)
+
+var (
+	// ErrUnsupportedArchive is returned for uploads that are not a zip or gzipped tar archive.
+	ErrUnsupportedArchive = errors.New("upload a .zip, .tar.gz or .tgz archive of the repository")
+	// ErrMirrorImportDisabled is returned when no local git mirror is configured.
+	ErrMirrorImportDisabled = errors.New("importing from the local git mirror is not configured")
+	// ErrMirrorRepositoryNotFound is returned for paths that are not a git repository inside the mirror.
+	ErrMirrorRepositoryNotFound = errors.New("no git repository found at that path on the mirror")
+)
+
+// RepositoryMetadataFiles lists, in order of preference, the top-level files whose text a
+// RepositoryReader returns: READMEs and the package manifests topics are read from. Names
+// are matched case-insensitively.
+var RepositoryMetadataFiles = []string{
+	"README.md", "README.markdown", "README.rst", "README.txt", "README",
+	"package.json", "composer.json", "Cargo.toml", "pyproject.toml",
+}
+
+// RepositoryFile is a file found in a repository, by its path relative to the repository root.
+type RepositoryFile struct {
+	Path string
+	Size int64
+}
+
+// RepositoryContents is what a RepositoryReader found in a code repository.
+type RepositoryContents struct {
+	Name        string
+	Files       []RepositoryFile
+	Metadata    map[string]string // Text of the RepositoryMetadataFiles present, by their name in that list
+	CommitCount int               // 0 when the source has no history, such as a plain archive
+	RemoteURL   string            // Where the repository was cloned from, when known
+}
+
+// RepositoryReader defines the interface for reading code repositories to import projects from.
+type RepositoryReader interface {
+	ReadArchive(archive io.ReaderAt, size int64, filename string) (*RepositoryContents, error)
+	ReadMirror(path string) (*RepositoryContents, error)
+}
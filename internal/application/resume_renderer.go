@@ -0,0 +1,56 @@
+package application
+
+import (
+	"devsearch-go/internal/domain"
+
+	"errors"
+	"io"
+)
+
+// Resume layouts supported by ResumeRenderer implementations.
+const (
+	ResumeLayoutClassic = "classic"
+	ResumeLayoutSidebar = "sidebar"
+)
+
+// ResumeLayouts lists the selectable resume layouts, the first one being the default.
+var ResumeLayouts = []string{ResumeLayoutClassic, ResumeLayoutSidebar}
+
+// resumeTopProjects is the number of top-voted projects included in a resume.
+const resumeTopProjects = 5
+
+// ErrUnknownResumeLayout is returned when a resume is requested in an unsupported layout.
+var ErrUnknownResumeLayout = errors.New("unknown resume layout")
+
+// ResumeLink is a labelled link printed on a resume.
+type ResumeLink struct {
+	Label string
+	URL   string
+}
+
+// ResumeData holds everything printed on a developer's resume.
+type ResumeData struct {
+	Profile     domain.Profile
+	TopSkills   []domain.Skill
+	OtherSkills []domain.Skill
+	Projects    []domain.Project
+	Links       []ResumeLink
+	ProfileURL  string
+}
+
+// ResumeRenderer defines the interface for rendering a resume into a printable document.
+type ResumeRenderer interface {
+	RenderPDF(w io.Writer, resume *ResumeData, layout string) error
+}
+
+// SplitSkills separates skills into top skills, which have a description, and other skills.
+func SplitSkills(skills []domain.Skill) (topSkills, otherSkills []domain.Skill) {
+	for _, skill := range skills {
+		if skill.Description != "" {
+			topSkills = append(topSkills, skill)
+		} else {
+			otherSkills = append(otherSkills, skill)
+		}
+	}
+	return topSkills, otherSkills
+}
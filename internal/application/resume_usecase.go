@@ -0,0 +1,461 @@
+package application
+
+import (
+	"devsearch-go/internal/domain"
+
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ResumeUseCase defines the business logic for exporting and importing resumes.
+type ResumeUseCase struct {
+	ProfileRepo    ProfileRepository
+	SkillRepo      SkillRepository
+	ExperienceRepo ExperienceRepository
+	EducationRepo  EducationRepository
+	CatalogRepo    SkillCatalogRepository
+	Renderer       ResumeRenderer
+}
+
+// NewResumeUseCase creates a new ResumeUseCase.
+func NewResumeUseCase(profileRepo ProfileRepository, skillRepo SkillRepository, experienceRepo ExperienceRepository, educationRepo EducationRepository, catalogRepo SkillCatalogRepository, renderer ResumeRenderer) *ResumeUseCase {
+	return &ResumeUseCase{
+		ProfileRepo:    profileRepo,
+		SkillRepo:      skillRepo,
+		ExperienceRepo: experienceRepo,
+		EducationRepo:  educationRepo,
+		CatalogRepo:    catalogRepo,
+		Renderer:       renderer,
+	}
+}
+
+// loadVisibleProfile loads a profile as seen by the viewer. uuid.Nil identifies an anonymous visitor.
+func (uc *ResumeUseCase) loadVisibleProfile(profileID, viewerID uuid.UUID) (*domain.Profile, error) {
+	profile, err := uc.ProfileRepo.FindProfileByID(profileID)
+	if err != nil {
+		return nil, fmt.Errorf("profile not found: %w", err)
+	}
+	if err := applyProfilePrivacy(profile, viewerID); err != nil {
+		return nil, err
+	}
+	return profile, nil
+}
+
+// BuildResume gathers the data printed on a profile's resume: skills split into top and
+// other skills, the top-voted projects and the profile's links. Only the fields the viewer
+// may see are included.
+func (uc *ResumeUseCase) BuildResume(profileID, viewerID uuid.UUID, baseURL string) (*ResumeData, error) {
+	profile, err := uc.loadVisibleProfile(profileID, viewerID)
+	if err != nil {
+		return nil, err
+	}
+
+	resume := ResumeData{
+		Profile:    *profile,
+		ProfileURL: fmt.Sprintf("%s/profile/%s", baseURL, profile.ID),
+	}
+	resume.TopSkills, resume.OtherSkills = SplitSkills(profile.Skills)
+
+	projects := append([]domain.Project(nil), profile.Projects...)
+	sort.SliceStable(projects, func(i, j int) bool {
+		if projects[i].VoteRatio != projects[j].VoteRatio {
+			return projects[i].VoteRatio > projects[j].VoteRatio
+		}
+		return projects[i].VoteTotal > projects[j].VoteTotal
+	})
+	if len(projects) > resumeTopProjects {
+		projects = projects[:resumeTopProjects]
+	}
+	resume.Projects = projects
+
+	for _, link := range []ResumeLink{
+		{Label: "GitHub", URL: profile.SocialGithub},
+		{Label: "LinkedIn", URL: profile.SocialLinkedin},
+		{Label: "Website", URL: profile.SocialWebsite},
+		{Label: "DevSearch", URL: resume.ProfileURL},
+	} {
+		if link.URL != "" {
+			resume.Links = append(resume.Links, link)
+		}
+	}
+
+	return &resume, nil
+}
+
+// RenderPDF writes a profile's resume as a PDF document in the given layout.
+// An empty layout selects the default one.
+func (uc *ResumeUseCase) RenderPDF(w io.Writer, profileID, viewerID uuid.UUID, layout, baseURL string) error {
+	if layout == "" {
+		layout = ResumeLayouts[0]
+	}
+	if !isResumeLayout(layout) {
+		return ErrUnknownResumeLayout
+	}
+
+	resume, err := uc.BuildResume(profileID, viewerID, baseURL)
+	if err != nil {
+		return err
+	}
+
+	if err := uc.Renderer.RenderPDF(w, resume, layout); err != nil {
+		return fmt.Errorf("failed to render resume: %w", err)
+	}
+	return nil
+}
+
+// ExportJSONResume builds a JSON Resume document from a profile, its skills and its projects.
+// baseURL is used to turn media paths and the canonical link into absolute URLs.
+// Only the fields the viewer may see are exported.
+func (uc *ResumeUseCase) ExportJSONResume(profileID, viewerID uuid.UUID, baseURL string) (*JSONResume, error) {
+	profile, err := uc.loadVisibleProfile(profileID, viewerID)
+	if err != nil {
+		return nil, err
+	}
+
+	resume := JSONResume{
+		Schema: JSONResumeSchema,
+		Basics: JSONResumeBasics{
+			Name:    profile.Name,
+			Label:   profile.ShortIntro,
+			Email:   profile.Email,
+			URL:     profile.SocialWebsite,
+			Summary: profile.Bio,
+		},
+		Work:      []JSONResumeWork{},
+		Education: []JSONResumeEducation{},
+		Skills:    []JSONResumeSkill{},
+		Projects:  []JSONResumeProject{},
+		Meta: &JSONResumeMeta{
+			Canonical:    fmt.Sprintf("%s/api/profiles/%s/resume", baseURL, profile.ID),
+			Version:      "v1.0.0",
+			LastModified: profile.UpdatedAt.Format(time.RFC3339),
+		},
+	}
+
+	if profile.ProfileImage != "" {
+		resume.Basics.Image = fmt.Sprintf("%s/media/%s", baseURL, profile.ProfileImage)
+	}
+	if profile.Location != "" {
+		resume.Basics.Location = &JSONResumeLocation{City: profile.Location}
+	}
+	if profile.SocialGithub != "" {
+		resume.Basics.Profiles = append(resume.Basics.Profiles, JSONResumeProfile{
+			Network:  "GitHub",
+			Username: usernameFromURL(profile.SocialGithub),
+			URL:      profile.SocialGithub,
+		})
+	}
+	if profile.SocialLinkedin != "" {
+		resume.Basics.Profiles = append(resume.Basics.Profiles, JSONResumeProfile{
+			Network:  "LinkedIn",
+			Username: usernameFromURL(profile.SocialLinkedin),
+			URL:      profile.SocialLinkedin,
+		})
+	}
+
+	for _, experience := range profile.Experiences {
+		resume.Work = append(resume.Work, JSONResumeWork{
+			Name:       experience.Company,
+			Position:   experience.Title,
+			Location:   experience.Location,
+			StartDate:  experience.StartDate.Format(jsonResumeDateLayout),
+			EndDate:    formatResumeEndDate(experience.EndDate),
+			Summary:    experience.Description,
+			Highlights: experience.TechnologyList(),
+		})
+	}
+
+	for _, education := range profile.Educations {
+		resume.Education = append(resume.Education, JSONResumeEducation{
+			Institution: education.School,
+			Area:        education.FieldOfStudy,
+			StudyType:   education.Degree,
+			StartDate:   education.StartDate.Format(jsonResumeDateLayout),
+			EndDate:     formatResumeEndDate(education.EndDate),
+		})
+	}
+
+	for _, skill := range profile.Skills {
+		resume.Skills = append(resume.Skills, JSONResumeSkill{
+			Name:     skill.Name,
+			Level:    skill.Level,
+			Keywords: splitKeywords(skill.Description),
+		})
+	}
+
+	for _, project := range profile.Projects {
+		entry := JSONResumeProject{
+			Name:        project.Title,
+			Description: project.Description,
+			URL:         project.DemoLink,
+			StartDate:   project.CreatedAt.Format(jsonResumeDateLayout),
+		}
+		if entry.URL == "" {
+			entry.URL = project.SourceLink
+		}
+		for _, tag := range project.Tags {
+			entry.Keywords = append(entry.Keywords, tag.Name)
+		}
+		resume.Projects = append(resume.Projects, entry)
+	}
+
+	return &resume, nil
+}
+
+// ParseJSONResume decodes and validates a JSON Resume document.
+func ParseJSONResume(r io.Reader) (*JSONResume, error) {
+	var resume JSONResume
+	if err := json.NewDecoder(r).Decode(&resume); err != nil {
+		return nil, fmt.Errorf("invalid JSON Resume document: %w", err)
+	}
+	if strings.TrimSpace(resume.Basics.Name) == "" {
+		return nil, fmt.Errorf("invalid JSON Resume document: basics.name is required")
+	}
+	return &resume, nil
+}
+
+// ImportJSONResume fills the user's profile from a JSON Resume document and adds the skills
+// the profile does not have yet. Skills are matched through the skill catalog.
+func (uc *ResumeUseCase) ImportJSONResume(userID uuid.UUID, resume *JSONResume) (*domain.Profile, error) {
+	owner, err := uc.ProfileRepo.FindProfileByUserID(userID)
+	if err != nil {
+		return nil, fmt.Errorf("profile not found for user: %w", err)
+	}
+
+	profile, err := uc.ProfileRepo.FindProfileByID(owner.ID)
+	if err != nil {
+		return nil, fmt.Errorf("profile not found: %w", err)
+	}
+
+	basics := resume.Basics
+	profile.Name = basics.Name
+	if basics.Label != "" {
+		profile.ShortIntro = basics.Label
+	}
+	if basics.Email != "" {
+		profile.Email = basics.Email
+	}
+	if basics.Summary != "" {
+		profile.Bio = basics.Summary
+	}
+	// Links that would not pass the profile form are left out
+	if link, err := normalizeLink(domain.LinkFieldWebsite, basics.URL); err == nil && link != "" {
+		profile.SocialWebsite = link
+	}
+	if basics.Location != nil {
+		var parts []string
+		for _, part := range []string{basics.Location.City, basics.Location.Region, basics.Location.CountryCode} {
+			if part != "" {
+				parts = append(parts, part)
+			}
+		}
+		if len(parts) > 0 {
+			profile.Location = strings.Join(parts, ", ")
+		}
+	}
+	for _, social := range basics.Profiles {
+		switch strings.ToLower(social.Network) {
+		case "github":
+			if link, err := normalizeLink(domain.LinkFieldGithub, social.URL); err == nil && link != "" {
+				profile.SocialGithub = link
+			}
+		case "linkedin":
+			if link, err := normalizeLink(domain.LinkFieldLinkedin, social.URL); err == nil && link != "" {
+				profile.SocialLinkedin = link
+			}
+		}
+	}
+
+	existing := make(map[string]bool)
+	for _, skill := range profile.Skills {
+		existing[domain.NormalizeSkillName(skill.Name)] = true
+	}
+
+	// Associations are saved separately below, keep them out of the profile update.
+	skills, experiences, educations := profile.Skills, profile.Experiences, profile.Educations
+	profile.Skills, profile.Experiences, profile.Educations = nil, nil, nil
+	profile.Projects = nil
+	if err := uc.ProfileRepo.UpdateProfile(profile); err != nil {
+		return nil, fmt.Errorf("failed to update profile: %w", err)
+	}
+	profile.Experiences, profile.Educations = experiences, educations
+
+	for _, entry := range resume.Skills {
+		name := strings.TrimSpace(entry.Name)
+		skill := domain.Skill{
+			OwnerID:     profile.ID,
+			Name:        name,
+			Description: strings.Join(entry.Keywords, ", "),
+		}
+		if level := strings.ToLower(entry.Level); domain.IsValidSkillLevel(level) {
+			skill.Level = level
+		}
+		if err := resolveCatalogSkill(uc.CatalogRepo, &skill); err != nil {
+			continue
+		}
+		if key := domain.NormalizeSkillName(skill.Name); existing[key] || existing[domain.NormalizeSkillName(name)] {
+			continue
+		}
+		if err := uc.SkillRepo.CreateSkill(&skill); err != nil {
+			return nil, fmt.Errorf("failed to create skill %q: %w", name, err)
+		}
+		existing[domain.NormalizeSkillName(skill.Name)] = true
+		skills = append(skills, skill)
+	}
+	profile.Skills = skills
+
+	if err := uc.importWork(profile, resume.Work); err != nil {
+		return nil, err
+	}
+	if err := uc.importEducation(profile, resume.Education); err != nil {
+		return nil, err
+	}
+
+	return profile, nil
+}
+
+// importWork adds the work entries that are not on the profile yet, matched by company,
+// position and start date.
+func (uc *ResumeUseCase) importWork(profile *domain.Profile, work []JSONResumeWork) error {
+	existing := make(map[string]bool)
+	for _, experience := range profile.Experiences {
+		existing[resumeEntryKey(experience.Company, experience.Title, experience.StartDate)] = true
+	}
+
+	for _, entry := range work {
+		startDate, err := parseResumeDate(entry.StartDate)
+		if err != nil || strings.TrimSpace(entry.Name) == "" || strings.TrimSpace(entry.Position) == "" {
+			continue
+		}
+		key := resumeEntryKey(entry.Name, entry.Position, startDate)
+		if existing[key] {
+			continue
+		}
+
+		experience := domain.Experience{
+			OwnerID:      profile.ID,
+			Company:      strings.TrimSpace(entry.Name),
+			Title:        strings.TrimSpace(entry.Position),
+			Location:     entry.Location,
+			StartDate:    startDate,
+			Description:  entry.Summary,
+			Technologies: strings.Join(entry.Highlights, ", "),
+		}
+		if endDate, err := parseResumeDate(entry.EndDate); err == nil {
+			experience.EndDate = &endDate
+		}
+		if err := validateExperience(&experience); err != nil {
+			continue
+		}
+		if err := uc.ExperienceRepo.CreateExperience(&experience); err != nil {
+			return fmt.Errorf("failed to create experience at %q: %w", experience.Company, err)
+		}
+		existing[key] = true
+		profile.Experiences = append(profile.Experiences, experience)
+	}
+	return nil
+}
+
+// importEducation adds the education entries that are not on the profile yet, matched by
+// institution, study type and start date.
+func (uc *ResumeUseCase) importEducation(profile *domain.Profile, educations []JSONResumeEducation) error {
+	existing := make(map[string]bool)
+	for _, education := range profile.Educations {
+		existing[resumeEntryKey(education.School, education.Degree, education.StartDate)] = true
+	}
+
+	for _, entry := range educations {
+		startDate, err := parseResumeDate(entry.StartDate)
+		if err != nil || strings.TrimSpace(entry.Institution) == "" {
+			continue
+		}
+		key := resumeEntryKey(entry.Institution, entry.StudyType, startDate)
+		if existing[key] {
+			continue
+		}
+
+		education := domain.Education{
+			OwnerID:      profile.ID,
+			School:       strings.TrimSpace(entry.Institution),
+			Degree:       strings.TrimSpace(entry.StudyType),
+			FieldOfStudy: entry.Area,
+			StartDate:    startDate,
+		}
+		if endDate, err := parseResumeDate(entry.EndDate); err == nil {
+			education.EndDate = &endDate
+		}
+		if err := validateEducation(&education); err != nil {
+			continue
+		}
+		if err := uc.EducationRepo.CreateEducation(&education); err != nil {
+			return fmt.Errorf("failed to create education at %q: %w", education.School, err)
+		}
+		existing[key] = true
+		profile.Educations = append(profile.Educations, education)
+	}
+	return nil
+}
+
+// jsonResumeDateLayout is the ISO 8601 date format used by JSON Resume.
+const jsonResumeDateLayout = "2006-01-02"
+
+// formatResumeEndDate formats an optional end date, leaving ongoing entries without one.
+func formatResumeEndDate(endDate *time.Time) string {
+	if endDate == nil {
+		return ""
+	}
+	return endDate.Format(jsonResumeDateLayout)
+}
+
+// parseResumeDate parses a JSON Resume date, which may omit the day or the month.
+func parseResumeDate(value string) (time.Time, error) {
+	for _, layout := range []string{jsonResumeDateLayout, "2006-01", "2006"} {
+		if date, err := time.Parse(layout, value); err == nil {
+			return date, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("invalid date %q", value)
+}
+
+// resumeEntryKey identifies a work or education entry when matching imported entries.
+func resumeEntryKey(name, title string, startDate time.Time) string {
+	return strings.ToLower(strings.TrimSpace(name)) + "|" + strings.ToLower(strings.TrimSpace(title)) + "|" + startDate.Format("2006-01")
+}
+
+// isResumeLayout reports whether layout is one of ResumeLayouts.
+func isResumeLayout(layout string) bool {
+	for _, known := range ResumeLayouts {
+		if known == layout {
+			return true
+		}
+	}
+	return false
+}
+
+// usernameFromURL returns the last path segment of a social profile URL.
+func usernameFromURL(rawURL string) string {
+	parsed, err := url.Parse(rawURL)
+	if err != nil {
+		return ""
+	}
+	segments := strings.Split(strings.Trim(parsed.Path, "/"), "/")
+	return segments[len(segments)-1]
+}
+
+// splitKeywords splits a comma separated skill description into keywords.
+func splitKeywords(description string) []string {
+	var keywords []string
+	for _, keyword := range strings.Split(description, ",") {
+		if keyword = strings.TrimSpace(keyword); keyword != "" {
+			keywords = append(keywords, keyword)
+		}
+	}
+	return keywords
+}
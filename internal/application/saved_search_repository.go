@@ -0,0 +1,17 @@
+package application
+
+import (
+	"devsearch-go/internal/domain"
+
+	"github.com/google/uuid"
+)
+
+// SavedSearchRepository defines the interface for data operations on saved developer searches.
+type SavedSearchRepository interface {
+	CreateSavedSearch(search *domain.SavedSearch) error
+	FindSavedSearchByID(id uuid.UUID) (*domain.SavedSearch, error)
+	FindSavedSearchesByOwner(ownerID uuid.UUID) ([]domain.SavedSearch, error)
+	FindSavedSearchesWithAlerts() ([]domain.SavedSearch, error)
+	UpdateSavedSearch(search *domain.SavedSearch) error
+	DeleteSavedSearch(id uuid.UUID) error
+}
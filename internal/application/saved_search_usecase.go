@@ -0,0 +1,229 @@
+package application
+
+import (
+	"devsearch-go/internal/domain"
+
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	// ErrSavedSearchNotFound is returned for saved searches that do not exist or belong to someone else.
+	ErrSavedSearchNotFound = errors.New("saved search not found")
+	// ErrInvalidSavedSearchName is returned for empty or overly long saved search names.
+	ErrInvalidSavedSearchName = errors.New("saved search name must be between 1 and 255 characters")
+)
+
+// SavedSearchSummary is a saved search with the number of matches that appeared since the owner last ran it.
+type SavedSearchSummary struct {
+	Search     domain.SavedSearch
+	NewMatches int64
+}
+
+// SavedSearchUseCase defines the business logic for saved developer searches and their alerts.
+type SavedSearchUseCase struct {
+	SavedSearchRepo SavedSearchRepository
+	ProfileRepo     ProfileRepository
+	MessageRepo     MessageRepository
+}
+
+// NewSavedSearchUseCase creates a new SavedSearchUseCase.
+func NewSavedSearchUseCase(savedSearchRepo SavedSearchRepository, profileRepo ProfileRepository, messageRepo MessageRepository) *SavedSearchUseCase {
+	return &SavedSearchUseCase{
+		SavedSearchRepo: savedSearchRepo,
+		ProfileRepo:     profileRepo,
+		MessageRepo:     messageRepo,
+	}
+}
+
+// SaveSearch stores a developer search for the user. Matches count as new from this moment on.
+func (uc *SavedSearchUseCase) SaveSearch(userID uuid.UUID, name string, filter ProfileFilter, alertsEnabled bool) (*domain.SavedSearch, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		name = strings.TrimSpace(filter.Query)
+	}
+	if name == "" || len(name) > 255 {
+		return nil, ErrInvalidSavedSearchName
+	}
+	owner, err := uc.ProfileRepo.FindProfileByUserID(userID)
+	if err != nil {
+		return nil, fmt.Errorf("profile not found for user: %w", err)
+	}
+
+	now := time.Now()
+	search := domain.SavedSearch{
+		OwnerID:         owner.ID,
+		Name:            name,
+		Query:           filter.Query,
+		SortBy:          filter.SortBy,
+		Availability:    filter.Availability,
+		WorkArrangement: filter.WorkArrangement,
+		Role:            filter.Role,
+		TimeZone:        filter.TimeZone,
+		MaxSalary:       filter.MaxSalary,
+		SalaryCurrency:  filter.SalaryCurrency,
+		AlertsEnabled:   alertsEnabled,
+		LastViewedAt:    now,
+		LastAlertedAt:   now,
+	}
+	if err := uc.SavedSearchRepo.CreateSavedSearch(&search); err != nil {
+		return nil, fmt.Errorf("failed to save search: %w", err)
+	}
+	return &search, nil
+}
+
+// GetSavedSearches retrieves the user's saved searches with their new matches since the last visit.
+func (uc *SavedSearchUseCase) GetSavedSearches(userID uuid.UUID) ([]SavedSearchSummary, error) {
+	owner, err := uc.ProfileRepo.FindProfileByUserID(userID)
+	if err != nil {
+		return nil, fmt.Errorf("profile not found for user: %w", err)
+	}
+	searches, err := uc.SavedSearchRepo.FindSavedSearchesByOwner(owner.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load saved searches: %w", err)
+	}
+
+	summaries := make([]SavedSearchSummary, 0, len(searches))
+	for _, search := range searches {
+		newMatches, err := uc.countMatchesSince(search, search.LastViewedAt)
+		if err != nil {
+			return nil, err
+		}
+		summaries = append(summaries, SavedSearchSummary{Search: search, NewMatches: newMatches})
+	}
+	return summaries, nil
+}
+
+// OpenSavedSearch returns the filter of one of the user's saved searches and marks its matches as seen.
+func (uc *SavedSearchUseCase) OpenSavedSearch(userID, searchID uuid.UUID) (ProfileFilter, error) {
+	search, err := uc.loadOwnSavedSearch(userID, searchID, ActionView)
+	if err != nil {
+		return ProfileFilter{}, err
+	}
+	search.LastViewedAt = time.Now()
+	if err := uc.SavedSearchRepo.UpdateSavedSearch(search); err != nil {
+		return ProfileFilter{}, fmt.Errorf("failed to update saved search: %w", err)
+	}
+	return SavedSearchFilter(*search), nil
+}
+
+// SetAlerts turns new-match alerts of one of the user's saved searches on or off.
+// Turning them on only alerts about matches appearing from then on.
+func (uc *SavedSearchUseCase) SetAlerts(userID, searchID uuid.UUID, enabled bool) error {
+	search, err := uc.loadOwnSavedSearch(userID, searchID, ActionEdit)
+	if err != nil {
+		return err
+	}
+	if enabled && !search.AlertsEnabled {
+		search.LastAlertedAt = time.Now()
+	}
+	search.AlertsEnabled = enabled
+	if err := uc.SavedSearchRepo.UpdateSavedSearch(search); err != nil {
+		return fmt.Errorf("failed to update saved search: %w", err)
+	}
+	return nil
+}
+
+// DeleteSavedSearch deletes one of the user's saved searches.
+func (uc *SavedSearchUseCase) DeleteSavedSearch(userID, searchID uuid.UUID) error {
+	search, err := uc.loadOwnSavedSearch(userID, searchID, ActionDelete)
+	if err != nil {
+		return err
+	}
+	if err := uc.SavedSearchRepo.DeleteSavedSearch(search.ID); err != nil {
+		return fmt.Errorf("failed to delete saved search: %w", err)
+	}
+	return nil
+}
+
+// SendAlerts messages the owners of saved searches with alerts about profiles that started
+// matching, or were updated, since their previous alert. It is run periodically by a background job.
+func (uc *SavedSearchUseCase) SendAlerts() error {
+	searches, err := uc.SavedSearchRepo.FindSavedSearchesWithAlerts()
+	if err != nil {
+		return fmt.Errorf("failed to load saved searches with alerts: %w", err)
+	}
+
+	var errs []error
+	for i := range searches {
+		if err := uc.sendAlert(&searches[i]); err != nil {
+			errs = append(errs, fmt.Errorf("saved search %s: %w", searches[i].ID, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// sendAlert sends the alert of a single saved search when it has new matches.
+func (uc *SavedSearchUseCase) sendAlert(search *domain.SavedSearch) error {
+	// Matches appearing while this alert is being sent are picked up by the next one
+	checkedAt := time.Now()
+	newMatches, err := uc.countMatchesSince(*search, search.LastAlertedAt)
+	if err != nil {
+		return err
+	}
+	if newMatches > 0 {
+		matches, noun := "matches", "developers match"
+		if newMatches == 1 {
+			matches, noun = "match", "developer matches"
+		}
+		message := domain.Message{
+			RecipientID: search.OwnerID,
+			Name:        "DevSearch",
+			Subject:     fmt.Sprintf("%d new %s for \"%s\"", newMatches, matches, search.Name),
+			Body: fmt.Sprintf("%d %s your saved search \"%s\" after joining or updating their profile since %s.\n\nSee them at /saved-search/%s",
+				newMatches, noun, search.Name, search.LastAlertedAt.Format("Jan 2, 2006 15:04"), search.ID),
+		}
+		if err := uc.MessageRepo.CreateMessage(&message); err != nil {
+			return fmt.Errorf("failed to send alert: %w", err)
+		}
+	}
+
+	search.LastAlertedAt = checkedAt
+	if err := uc.SavedSearchRepo.UpdateSavedSearch(search); err != nil {
+		return fmt.Errorf("failed to update saved search: %w", err)
+	}
+	return nil
+}
+
+// countMatchesSince counts the profiles matching a saved search that joined or changed after since.
+func (uc *SavedSearchUseCase) countMatchesSince(search domain.SavedSearch, since time.Time) (int64, error) {
+	filter := SavedSearchFilter(search)
+	filter.UpdatedSince = since
+	_, total, err := uc.ProfileRepo.FindAllProfiles(filter, 1, 1)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count matches: %w", err)
+	}
+	return total, nil
+}
+
+// loadOwnSavedSearch loads a saved search the user may perform the action on, treating saved
+// searches of other users as missing.
+func (uc *SavedSearchUseCase) loadOwnSavedSearch(userID, searchID uuid.UUID, action Action) (*domain.SavedSearch, error) {
+	principal, err := loadPrincipal(uc.ProfileRepo, userID)
+	if err != nil {
+		return nil, err
+	}
+	search, err := uc.SavedSearchRepo.FindSavedSearchByID(searchID)
+	if err != nil || !Can(principal, action, search) {
+		return nil, ErrSavedSearchNotFound
+	}
+	return search, nil
+}
+
+// SavedSearchFilter returns the developer search filter stored in a saved search.
+func SavedSearchFilter(search domain.SavedSearch) ProfileFilter {
+	return ProfileFilter{
+		Query:           search.Query,
+		SortBy:          search.SortBy,
+		Availability:    search.Availability,
+		WorkArrangement: search.WorkArrangement,
+		Role:            search.Role,
+		TimeZone:        search.TimeZone,
+		MaxSalary:       search.MaxSalary,
+		SalaryCurrency:  search.SalaryCurrency,
+	}
+}
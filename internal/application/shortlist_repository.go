@@ -0,0 +1,21 @@
+package application
+
+import (
+	"devsearch-go/internal/domain"
+
+	"github.com/google/uuid"
+)
+
+// ShortlistRepository defines the interface for data operations on shortlists and their candidates.
+type ShortlistRepository interface {
+	FindShortlistsByOwner(ownerID uuid.UUID) ([]domain.Shortlist, error)
+	FindShortlistByID(id uuid.UUID) (*domain.Shortlist, error)
+	CreateShortlist(shortlist *domain.Shortlist) error
+	UpdateShortlistName(id uuid.UUID, name string) error
+	DeleteShortlist(id uuid.UUID) error
+	FindCandidateByID(id uuid.UUID) (*domain.ShortlistCandidate, error)
+	FindCandidate(shortlistID, profileID uuid.UUID) (*domain.ShortlistCandidate, error)
+	CreateCandidate(candidate *domain.ShortlistCandidate) error
+	UpdateCandidate(candidate *domain.ShortlistCandidate) error
+	DeleteCandidate(id uuid.UUID) error
+}
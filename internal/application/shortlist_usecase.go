@@ -0,0 +1,253 @@
+package application
+
+import (
+	"devsearch-go/internal/domain"
+
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// maxShortlistNameLength bounds the length of shortlist names.
+const maxShortlistNameLength = 255
+
+var (
+	// ErrShortlistNotFound is returned for shortlists that do not exist or belong to someone else.
+	ErrShortlistNotFound = errors.New("shortlist not found")
+	// ErrCandidateNotFound is returned for candidates that do not exist or sit on someone else's shortlist.
+	ErrCandidateNotFound = errors.New("candidate not found")
+	// ErrAlreadyShortlisted is returned when the profile is already on the shortlist.
+	ErrAlreadyShortlisted = errors.New("this developer is already on the shortlist")
+	// ErrInvalidShortlistName is returned for empty or overly long shortlist names.
+	ErrInvalidShortlistName = errors.New("shortlist name must be between 1 and 255 characters")
+	// ErrInvalidPipelineStage is returned for a stage outside domain.PipelineStages.
+	ErrInvalidPipelineStage = errors.New("unknown pipeline stage")
+)
+
+// ShortlistUseCase defines the business logic for recruiter shortlists and candidate pipelines.
+type ShortlistUseCase struct {
+	ShortlistRepo ShortlistRepository
+	ProfileRepo   ProfileRepository
+}
+
+// NewShortlistUseCase creates a new ShortlistUseCase.
+func NewShortlistUseCase(shortlistRepo ShortlistRepository, profileRepo ProfileRepository) *ShortlistUseCase {
+	return &ShortlistUseCase{
+		ShortlistRepo: shortlistRepo,
+		ProfileRepo:   profileRepo,
+	}
+}
+
+// GetShortlists retrieves the user's shortlists with their candidates, without candidate profiles.
+func (uc *ShortlistUseCase) GetShortlists(userID uuid.UUID) ([]domain.Shortlist, error) {
+	owner, err := uc.ProfileRepo.FindProfileByUserID(userID)
+	if err != nil {
+		return nil, fmt.Errorf("profile not found for user: %w", err)
+	}
+	return uc.ShortlistRepo.FindShortlistsByOwner(owner.ID)
+}
+
+// GetShortlist retrieves one of the user's shortlists with its candidates' profiles.
+// Candidates who made their profile private since they were added are reduced to their IDs.
+func (uc *ShortlistUseCase) GetShortlist(userID, shortlistID uuid.UUID) (*domain.Shortlist, error) {
+	shortlist, err := uc.loadOwnShortlist(userID, shortlistID, ActionView)
+	if err != nil {
+		return nil, err
+	}
+	for i := range shortlist.Candidates {
+		profile := &shortlist.Candidates[i].Profile
+		if err := applyProfilePrivacy(profile, userID); err != nil {
+			*profile = domain.Profile{ID: profile.ID, UserID: profile.UserID}
+		}
+	}
+	return shortlist, nil
+}
+
+// CreateShortlist creates an empty shortlist for the user.
+func (uc *ShortlistUseCase) CreateShortlist(userID uuid.UUID, name string) (*domain.Shortlist, error) {
+	name, err := validateShortlistName(name)
+	if err != nil {
+		return nil, err
+	}
+	owner, err := uc.ProfileRepo.FindProfileByUserID(userID)
+	if err != nil {
+		return nil, fmt.Errorf("profile not found for user: %w", err)
+	}
+
+	shortlist := domain.Shortlist{OwnerID: owner.ID, Name: name}
+	if err := uc.ShortlistRepo.CreateShortlist(&shortlist); err != nil {
+		return nil, fmt.Errorf("failed to create shortlist: %w", err)
+	}
+	return &shortlist, nil
+}
+
+// RenameShortlist changes the name of one of the user's shortlists.
+func (uc *ShortlistUseCase) RenameShortlist(userID, shortlistID uuid.UUID, name string) error {
+	name, err := validateShortlistName(name)
+	if err != nil {
+		return err
+	}
+	shortlist, err := uc.loadOwnShortlist(userID, shortlistID, ActionEdit)
+	if err != nil {
+		return err
+	}
+	if err := uc.ShortlistRepo.UpdateShortlistName(shortlist.ID, name); err != nil {
+		return fmt.Errorf("failed to rename shortlist: %w", err)
+	}
+	return nil
+}
+
+// DeleteShortlist deletes one of the user's shortlists together with its candidates and notes.
+func (uc *ShortlistUseCase) DeleteShortlist(userID, shortlistID uuid.UUID) error {
+	shortlist, err := uc.loadOwnShortlist(userID, shortlistID, ActionDelete)
+	if err != nil {
+		return err
+	}
+	if err := uc.ShortlistRepo.DeleteShortlist(shortlist.ID); err != nil {
+		return fmt.Errorf("failed to delete shortlist: %w", err)
+	}
+	return nil
+}
+
+// AddCandidate puts a profile on one of the user's shortlists at the first pipeline stage.
+func (uc *ShortlistUseCase) AddCandidate(userID, shortlistID, profileID uuid.UUID) (*domain.ShortlistCandidate, error) {
+	shortlist, err := uc.loadOwnShortlist(userID, shortlistID, ActionEdit)
+	if err != nil {
+		return nil, err
+	}
+	profile, err := uc.ProfileRepo.FindProfileByID(profileID)
+	if err != nil {
+		return nil, fmt.Errorf("profile not found: %w", err)
+	}
+	if err := applyProfilePrivacy(profile, userID); err != nil {
+		return nil, err
+	}
+	if _, err := uc.ShortlistRepo.FindCandidate(shortlist.ID, profile.ID); err == nil {
+		return nil, ErrAlreadyShortlisted
+	}
+
+	candidate := domain.ShortlistCandidate{ShortlistID: shortlist.ID, ProfileID: profile.ID, Stage: domain.PipelineStageShortlisted}
+	if err := uc.ShortlistRepo.CreateCandidate(&candidate); err != nil {
+		return nil, fmt.Errorf("failed to add candidate: %w", err)
+	}
+	return &candidate, nil
+}
+
+// UpdateCandidate moves a candidate to another pipeline stage and replaces its note.
+func (uc *ShortlistUseCase) UpdateCandidate(userID, candidateID uuid.UUID, stage, note string) (*domain.ShortlistCandidate, error) {
+	if !contains(domain.PipelineStages, stage) {
+		return nil, ErrInvalidPipelineStage
+	}
+	candidate, err := uc.loadOwnCandidate(userID, candidateID, ActionEdit)
+	if err != nil {
+		return nil, err
+	}
+
+	candidate.Stage = stage
+	candidate.Note = strings.TrimSpace(note)
+	if err := uc.ShortlistRepo.UpdateCandidate(candidate); err != nil {
+		return nil, fmt.Errorf("failed to update candidate: %w", err)
+	}
+	return candidate, nil
+}
+
+// RemoveCandidate takes a candidate off the user's shortlist. It returns the removed candidate
+// so callers can link back to its shortlist.
+func (uc *ShortlistUseCase) RemoveCandidate(userID, candidateID uuid.UUID) (*domain.ShortlistCandidate, error) {
+	candidate, err := uc.loadOwnCandidate(userID, candidateID, ActionEdit)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.ShortlistRepo.DeleteCandidate(candidate.ID); err != nil {
+		return nil, fmt.Errorf("failed to remove candidate: %w", err)
+	}
+	return candidate, nil
+}
+
+// ExportCSV writes one of the user's shortlists as CSV, one candidate per row.
+// Profile URLs are made absolute with baseURL; privacy settings apply as on the profile page.
+func (uc *ShortlistUseCase) ExportCSV(w io.Writer, userID, shortlistID uuid.UUID, baseURL string) error {
+	shortlist, err := uc.GetShortlist(userID, shortlistID)
+	if err != nil {
+		return err
+	}
+
+	writer := csv.NewWriter(w)
+	if err := writer.Write([]string{"Name", "Username", "Profile URL", "Email", "Location", "Stage", "Note", "Added"}); err != nil {
+		return err
+	}
+	for _, candidate := range shortlist.Candidates {
+		profile := candidate.Profile
+		name := profile.Name
+		if name == "" && profile.Username == "" {
+			name = "Private developer"
+		}
+		row := []string{
+			name,
+			profile.Username,
+			baseURL + profile.URL(),
+			profile.Email,
+			profile.Location,
+			candidate.Stage,
+			candidate.Note,
+			candidate.CreatedAt.Format(time.DateOnly),
+		}
+		for i := range row {
+			row[i] = csvSafe(row[i])
+		}
+		if err := writer.Write(row); err != nil {
+			return err
+		}
+	}
+	writer.Flush()
+	return writer.Error()
+}
+
+// loadOwnShortlist loads a shortlist the user may perform the action on, treating shortlists
+// of other users as missing.
+func (uc *ShortlistUseCase) loadOwnShortlist(userID, shortlistID uuid.UUID, action Action) (*domain.Shortlist, error) {
+	principal, err := loadPrincipal(uc.ProfileRepo, userID)
+	if err != nil {
+		return nil, err
+	}
+	shortlist, err := uc.ShortlistRepo.FindShortlistByID(shortlistID)
+	if err != nil || !Can(principal, action, shortlist) {
+		return nil, ErrShortlistNotFound
+	}
+	return shortlist, nil
+}
+
+// loadOwnCandidate loads a candidate whose shortlist the user may perform the action on,
+// treating candidates on other users' shortlists as missing.
+func (uc *ShortlistUseCase) loadOwnCandidate(userID, candidateID uuid.UUID, action Action) (*domain.ShortlistCandidate, error) {
+	candidate, err := uc.ShortlistRepo.FindCandidateByID(candidateID)
+	if err != nil {
+		return nil, ErrCandidateNotFound
+	}
+	if _, err := uc.loadOwnShortlist(userID, candidate.ShortlistID, action); err != nil {
+		return nil, ErrCandidateNotFound
+	}
+	return candidate, nil
+}
+
+// validateShortlistName trims a shortlist name and checks its length.
+func validateShortlistName(name string) (string, error) {
+	name = strings.TrimSpace(name)
+	if name == "" || len(name) > maxShortlistNameLength {
+		return "", ErrInvalidShortlistName
+	}
+	return name, nil
+}
+
+// csvSafe keeps spreadsheet applications from evaluating a cell as a formula.
+func csvSafe(value string) string {
+	if value != "" && strings.ContainsRune("=+-@\t\r", rune(value[0])) {
+		return "'" + value
+	}
+	return value
+}
@@ -0,0 +1,164 @@
+package application
+
+import (
+	"devsearch-go/internal/domain"
+
+	"math"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// profileSkillWeight is the base weight of a listed skill in a profile's feature vector.
+	profileSkillWeight = 1.0
+	// profileTagWeight is the base weight of a project tag in a profile's feature vector.
+	profileTagWeight = 0.5
+	// projectTagFeatureWeight is the base weight of a tag in a project's feature vector.
+	projectTagFeatureWeight = 1.0
+	// projectTermWeight is the base weight of a title or description word in a project's feature vector.
+	projectTermWeight = 0.3
+	// minTermLength is the length below which words are not used as project features.
+	minTermLength = 3
+	// maxFeatureItems is the number of items above which a feature is ignored: it says little
+	// about similarity and would dominate the cost of comparing every pair sharing it.
+	maxFeatureItems = 500
+	// minSimilarity is the score below which two items are not considered neighbours.
+	minSimilarity = 0.05
+)
+
+// stopWords are common English words that say nothing about what a project is about.
+var stopWords = map[string]bool{
+	"and": true, "the": true, "for": true, "with": true, "that": true, "this": true, "from": true,
+	"are": true, "was": true, "were": true, "you": true, "your": true, "our": true, "its": true,
+	"into": true, "using": true, "use": true, "used": true, "can": true, "has": true, "have": true,
+	"not": true, "but": true, "all": true, "any": true, "app": true, "project": true, "built": true,
+	"which": true, "will": true, "also": true, "more": true, "than": true, "then": true, "out": true,
+}
+
+// featureVector maps feature keys to their base weights.
+type featureVector map[string]float64
+
+// neighbor is an item together with its similarity to another item.
+type neighbor struct {
+	ID    uuid.UUID
+	Score float64
+}
+
+// profileFeatures describes a profile by its listed skills and the tags of its projects.
+func profileFeatures(profile domain.Profile) featureVector {
+	features := featureVector{}
+	for _, skill := range profile.Skills {
+		key := "skill:" + domain.NormalizeSkillName(skill.Name)
+		if skill.CatalogSkillID != nil {
+			key = "skill:" + skill.CatalogSkillID.String()
+		}
+		features[key] = profileSkillWeight
+	}
+	for _, project := range profile.Projects {
+		for _, tag := range project.Tags {
+			if key := domain.NormalizeSkillName(tag.Name); key != "" {
+				features["tag:"+key] = profileTagWeight
+			}
+		}
+	}
+	return features
+}
+
+// projectFeatures describes a project by its tags and the words of its title and description.
+func projectFeatures(project domain.Project) featureVector {
+	features := featureVector{}
+	for _, term := range descriptionTerms(project.Title + " " + project.Description) {
+		features["term:"+term] = projectTermWeight
+	}
+	for _, tag := range project.Tags {
+		if key := domain.NormalizeSkillName(tag.Name); key != "" {
+			features["tag:"+key] = projectTagFeatureWeight
+		}
+	}
+	return features
+}
+
+// descriptionTerms splits text into lower case words, leaving out short and common ones.
+func descriptionTerms(text string) []string {
+	words := strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	terms := words[:0]
+	for _, word := range words {
+		if len([]rune(word)) >= minTermLength && !stopWords[word] {
+			terms = append(terms, word)
+		}
+	}
+	return terms
+}
+
+// nearestNeighbors returns up to topN neighbours for every item, most similar first. Similarity
+// is the cosine of the items' feature vectors, with each feature weighted by how rare it is, so
+// that sharing a niche skill counts for more than sharing a ubiquitous one. Only items sharing
+// at least one feature are compared.
+func nearestNeighbors(items map[uuid.UUID]featureVector, topN int) map[uuid.UUID][]neighbor {
+	holders := make(map[string][]uuid.UUID)
+	for id, features := range items {
+		for feature := range features {
+			holders[feature] = append(holders[feature], id)
+		}
+	}
+
+	total := float64(len(items))
+	weighted := make(map[uuid.UUID]featureVector, len(items))
+	norms := make(map[uuid.UUID]float64, len(items))
+	for id, features := range items {
+		vector := featureVector{}
+		norm := 0.0
+		for feature, base := range features {
+			count := len(holders[feature])
+			if count > maxFeatureItems {
+				continue
+			}
+			weight := base * math.Log(1+total/float64(count))
+			vector[feature] = weight
+			norm += weight * weight
+		}
+		weighted[id] = vector
+		norms[id] = math.Sqrt(norm)
+	}
+
+	result := make(map[uuid.UUID][]neighbor, len(items))
+	for id, vector := range weighted {
+		if norms[id] == 0 {
+			continue
+		}
+		dots := make(map[uuid.UUID]float64)
+		for feature, weight := range vector {
+			for _, other := range holders[feature] {
+				if other == id {
+					continue
+				}
+				if otherWeight, ok := weighted[other][feature]; ok {
+					dots[other] += weight * otherWeight
+				}
+			}
+		}
+
+		neighbors := make([]neighbor, 0, len(dots))
+		for other, dot := range dots {
+			if score := dot / (norms[id] * norms[other]); score >= minSimilarity {
+				neighbors = append(neighbors, neighbor{ID: other, Score: score})
+			}
+		}
+		sort.Slice(neighbors, func(i, j int) bool {
+			if neighbors[i].Score != neighbors[j].Score {
+				return neighbors[i].Score > neighbors[j].Score
+			}
+			return neighbors[i].ID.String() < neighbors[j].ID.String()
+		})
+		if len(neighbors) > topN {
+			neighbors = neighbors[:topN]
+		}
+		result[id] = neighbors
+	}
+	return result
+}
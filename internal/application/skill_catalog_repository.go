@@ -0,0 +1,16 @@
+package application
+
+import (
+	"devsearch-go/internal/domain"
+
+	"github.com/google/uuid"
+)
+
+// SkillCatalogRepository defines the interface for canonical skill catalog data operations.
+type SkillCatalogRepository interface {
+	FindCatalogSkillBySlugOrAlias(key string) (*domain.CatalogSkill, error)
+	SearchCatalogSkills(query string, limit int) ([]domain.CatalogSkill, error)
+	CreateCatalogSkill(catalogSkill *domain.CatalogSkill) error
+	AddSkillAlias(alias *domain.SkillAlias) error
+	FindUncatalogedSkills(afterID uuid.UUID, limit int) ([]domain.Skill, error)
+}
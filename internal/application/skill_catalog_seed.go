@@ -0,0 +1,50 @@
+package application
+
+// DefaultSkillCatalog is the catalog seeded on start, with the spellings developers commonly use.
+var DefaultSkillCatalog = []CatalogSeed{
+	{Name: "Go", Aliases: []string{"golang", "go lang", "go-lang"}},
+	{Name: "Python", Aliases: []string{"python3", "py"}},
+	{Name: "JavaScript", Aliases: []string{"js", "javascript es6", "ecmascript"}},
+	{Name: "TypeScript", Aliases: []string{"ts"}},
+	{Name: "Java"},
+	{Name: "Kotlin"},
+	{Name: "C"},
+	{Name: "C++", Aliases: []string{"cpp", "cplusplus"}},
+	{Name: "C#", Aliases: []string{"csharp", "c sharp"}},
+	{Name: "Rust", Aliases: []string{"rustlang"}},
+	{Name: "Ruby"},
+	{Name: "Ruby on Rails", Aliases: []string{"rails", "ror"}},
+	{Name: "PHP"},
+	{Name: "Swift"},
+	{Name: "Django"},
+	{Name: "Flask"},
+	{Name: "FastAPI"},
+	{Name: "Node.js", Aliases: []string{"node", "nodejs"}},
+	{Name: "React", Aliases: []string{"reactjs", "react.js"}},
+	{Name: "Vue.js", Aliases: []string{"vue", "vuejs"}},
+	{Name: "Angular", Aliases: []string{"angularjs"}},
+	{Name: "HTML", Aliases: []string{"html5"}},
+	{Name: "CSS", Aliases: []string{"css3"}},
+	{Name: "Gin", Aliases: []string{"gin-gonic", "gin gonic"}},
+	{Name: "GORM"},
+	{Name: "PostgreSQL", Aliases: []string{"postgres", "psql", "pgsql"}},
+	{Name: "MySQL"},
+	{Name: "SQLite"},
+	{Name: "MongoDB", Aliases: []string{"mongo"}},
+	{Name: "Redis"},
+	{Name: "SQL"},
+	{Name: "Docker"},
+	{Name: "Kubernetes", Aliases: []string{"k8s", "kube"}},
+	{Name: "Git"},
+	{Name: "Linux"},
+	{Name: "AWS", Aliases: []string{"amazon web services"}},
+	{Name: "Google Cloud", Aliases: []string{"gcp", "google cloud platform"}},
+	{Name: "Azure", Aliases: []string{"microsoft azure"}},
+	{Name: "GraphQL"},
+	{Name: "REST", Aliases: []string{"rest api", "restful"}},
+	{Name: "gRPC"},
+	{Name: "Kafka", Aliases: []string{"apache kafka"}},
+	{Name: "RabbitMQ"},
+	{Name: "Terraform"},
+	{Name: "Machine Learning", Aliases: []string{"ml"}},
+}
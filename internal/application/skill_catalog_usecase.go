@@ -0,0 +1,158 @@
+package application
+
+import (
+	"devsearch-go/internal/domain"
+
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+// skillMigrationBatchSize is the number of free-text skills mapped to the catalog per query.
+const skillMigrationBatchSize = 100
+
+// maxYearsOfExperience bounds the years of experience that can be declared for a skill.
+const maxYearsOfExperience = 60
+
+// ErrUnknownSkill is returned when a skill name matches no catalog skill or alias.
+var ErrUnknownSkill = errors.New("skill is not in the catalog")
+
+// CatalogSeed describes a catalog skill and its aliases used to seed the catalog.
+type CatalogSeed struct {
+	Name    string
+	Aliases []string
+}
+
+// SkillCatalogUseCase defines the business logic for the canonical skill catalog.
+type SkillCatalogUseCase struct {
+	CatalogRepo SkillCatalogRepository
+	SkillRepo   SkillRepository
+}
+
+// NewSkillCatalogUseCase creates a new SkillCatalogUseCase.
+func NewSkillCatalogUseCase(catalogRepo SkillCatalogRepository, skillRepo SkillRepository) *SkillCatalogUseCase {
+	return &SkillCatalogUseCase{
+		CatalogRepo: catalogRepo,
+		SkillRepo:   skillRepo,
+	}
+}
+
+// SeedCatalog creates the seeded catalog skills that are missing and adds missing aliases to
+// existing ones. It is safe to run on every start.
+func (uc *SkillCatalogUseCase) SeedCatalog(seeds []CatalogSeed) error {
+	for _, seed := range seeds {
+		slug := domain.NormalizeSkillName(seed.Name)
+		catalogSkill, err := uc.CatalogRepo.FindCatalogSkillBySlugOrAlias(slug)
+		if err != nil {
+			catalogSkill = &domain.CatalogSkill{Name: seed.Name, Slug: slug}
+			if err := uc.CatalogRepo.CreateCatalogSkill(catalogSkill); err != nil {
+				return fmt.Errorf("failed to seed catalog skill %q: %w", seed.Name, err)
+			}
+		}
+
+		known := map[string]bool{catalogSkill.Slug: true}
+		for _, alias := range catalogSkill.Aliases {
+			known[alias.Alias] = true
+		}
+		for _, name := range seed.Aliases {
+			alias := domain.NormalizeSkillName(name)
+			if alias == "" || known[alias] {
+				continue
+			}
+			// An alias already claimed by another catalog skill is left where it is.
+			if _, err := uc.CatalogRepo.FindCatalogSkillBySlugOrAlias(alias); err == nil {
+				continue
+			}
+			if err := uc.CatalogRepo.AddSkillAlias(&domain.SkillAlias{CatalogSkillID: catalogSkill.ID, Alias: alias}); err != nil {
+				return fmt.Errorf("failed to seed alias %q of %q: %w", name, seed.Name, err)
+			}
+			known[alias] = true
+		}
+	}
+	return nil
+}
+
+// SearchCatalog returns catalog skills whose name or an alias starts with the query, for autocomplete.
+func (uc *SkillCatalogUseCase) SearchCatalog(query string, limit int) ([]domain.CatalogSkill, error) {
+	if strings.TrimSpace(query) == "" {
+		return []domain.CatalogSkill{}, nil
+	}
+	return uc.CatalogRepo.SearchCatalogSkills(query, limit)
+}
+
+// MigrateSkills maps free-text skills that are not linked to the catalog yet to catalog skills.
+// Names the catalog does not know stay free text. It returns the number of skills mapped.
+func (uc *SkillCatalogUseCase) MigrateSkills() (int, error) {
+	migrated := 0
+	afterID := uuid.Nil
+	for {
+		skills, err := uc.CatalogRepo.FindUncatalogedSkills(afterID, skillMigrationBatchSize)
+		if err != nil {
+			return migrated, fmt.Errorf("failed to load skills to migrate: %w", err)
+		}
+		if len(skills) == 0 {
+			return migrated, nil
+		}
+
+		for i := range skills {
+			skill := &skills[i]
+			afterID = skill.ID
+			if err := resolveCatalogSkill(uc.CatalogRepo, skill); err != nil || skill.CatalogSkillID == nil {
+				continue
+			}
+			if err := uc.SkillRepo.UpdateSkill(skill); err != nil {
+				return migrated, fmt.Errorf("failed to map skill %s to the catalog: %w", skill.ID, err)
+			}
+			migrated++
+		}
+	}
+}
+
+// resolveCatalogSkill links a skill to the catalog skill matching its name or one of its
+// aliases and renames it to the canonical name. Unknown names are kept as free text, unlinked.
+func resolveCatalogSkill(catalogRepo SkillCatalogRepository, skill *domain.Skill) error {
+	skill.Name = strings.TrimSpace(skill.Name)
+	skill.CatalogSkillID = nil
+	skill.CatalogSkill = nil
+
+	catalogSkill, err := findCatalogSkill(catalogRepo, skill.Name)
+	if errors.Is(err, ErrUnknownSkill) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	skill.CatalogSkillID = &catalogSkill.ID
+	skill.Name = catalogSkill.Name
+	return nil
+}
+
+// findCatalogSkill returns the catalog skill matching a name or one of its aliases. Free text
+// never adds entries to the catalog, which only grows through the seeded skills.
+func findCatalogSkill(catalogRepo SkillCatalogRepository, name string) (*domain.CatalogSkill, error) {
+	name = strings.TrimSpace(name)
+	slug := domain.NormalizeSkillName(name)
+	if slug == "" {
+		return nil, fmt.Errorf("skill name is required")
+	}
+
+	catalogSkill, err := catalogRepo.FindCatalogSkillBySlugOrAlias(slug)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownSkill, name)
+	}
+	return catalogSkill, nil
+}
+
+// validateSkillProficiency checks a skill's proficiency level and years of experience.
+func validateSkillProficiency(level string, years int) error {
+	if !domain.IsValidSkillLevel(level) {
+		return fmt.Errorf("unknown skill level %q", level)
+	}
+	if years < 0 || years > maxYearsOfExperience {
+		return fmt.Errorf("years of experience must be between 0 and %d", maxYearsOfExperience)
+	}
+	return nil
+}
@@ -0,0 +1,11 @@
+package application
+
+import (
+	"devsearch-go/internal/domain"
+)
+
+// SlugRedirectRepository defines the interface for data operations on redirects from old usernames and slugs.
+type SlugRedirectRepository interface {
+	SaveSlugRedirect(redirect *domain.SlugRedirect) error
+	FindSlugRedirect(kind, slug string) (*domain.SlugRedirect, error)
+}
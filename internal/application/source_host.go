@@ -0,0 +1,31 @@
+package application
+
+import (
+	"errors"
+	"net/url"
+	"time"
+)
+
+var (
+	// ErrSourceRepositoryNotFound is returned by a SourceHost for repositories that do not exist
+	// or are not public.
+	ErrSourceRepositoryNotFound = errors.New("repository not found on the code host")
+	// ErrSourceHostRateLimited is returned by a SourceHost while it refuses further requests.
+	ErrSourceHostRateLimited = errors.New("code host rate limit reached")
+)
+
+// SourceRepository is what a code host reports about a repository.
+type SourceRepository struct {
+	Stars        int
+	LastCommitAt *time.Time // Nil for repositories without commits
+	License      string     // SPDX identifier or name, "" when the host found none
+	Archived     bool
+}
+
+// SourceHost defines the interface for the code hosts project source links point to.
+type SourceHost interface {
+	// RepositoryPath returns the path identifying the repository a source link points to on
+	// the host, such as "owner/name", or "" when the link is not on this host.
+	RepositoryPath(link *url.URL) string
+	FetchRepository(repositoryPath string) (*SourceRepository, error)
+}
@@ -0,0 +1,17 @@
+package application
+
+import (
+	"devsearch-go/internal/domain"
+
+	"time"
+)
+
+// SourceStatsRepository defines the interface for data operations on the code host statistics
+// of project source links.
+type SourceStatsRepository interface {
+	// FindProjectsDueForSourceSync retrieves up to limit projects with a source link that was
+	// never checked, changed since it was, or was last checked before checkedBefore, least
+	// recently checked first, with their current statistics.
+	FindProjectsDueForSourceSync(checkedBefore time.Time, limit int) ([]domain.Project, error)
+	SaveSourceRepoStats(stats *domain.SourceRepoStats) error
+}
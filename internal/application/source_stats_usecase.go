@@ -0,0 +1,114 @@
+package application
+
+import (
+	"devsearch-go/internal/domain"
+
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const (
+	// sourceStatsRefreshAge is how long the statistics of a source link are kept before they are fetched again.
+	sourceStatsRefreshAge = 12 * time.Hour
+	// sourceStatsBatchSize limits how many source links one run of the sync job checks.
+	sourceStatsBatchSize = 200
+	// maxSourceStatsErrorLength keeps LastError within its column.
+	maxSourceStatsErrorLength = 255
+)
+
+// SourceStatsUseCase defines the business logic for keeping the code host statistics of
+// project source links up to date.
+type SourceStatsUseCase struct {
+	StatsRepo SourceStatsRepository
+	Hosts     []SourceHost
+}
+
+// NewSourceStatsUseCase creates a new SourceStatsUseCase.
+func NewSourceStatsUseCase(statsRepo SourceStatsRepository, hosts []SourceHost) *SourceStatsUseCase {
+	return &SourceStatsUseCase{StatsRepo: statsRepo, Hosts: hosts}
+}
+
+// SyncSourceStats fetches stars, last commit date, license and archived status for the source
+// links that are due, and stores them for project cards. Links on unknown hosts and failed
+// fetches are stored with the reason, so they wait for the next refresh like the others. A
+// host that reports its rate limit is left alone until the next run. It is run by a background job.
+func (uc *SourceStatsUseCase) SyncSourceStats() error {
+	projects, err := uc.StatsRepo.FindProjectsDueForSourceSync(time.Now().Add(-sourceStatsRefreshAge), sourceStatsBatchSize)
+	if err != nil {
+		return fmt.Errorf("failed to load projects for source sync: %w", err)
+	}
+
+	rateLimited := make(map[SourceHost]bool)
+	var errs []error
+	for i := range projects {
+		project := &projects[i]
+		stats, host, repositoryPath := uc.sourceStatsFor(project)
+		if host != nil {
+			if rateLimited[host] {
+				continue
+			}
+			repository, err := host.FetchRepository(repositoryPath)
+			if errors.Is(err, ErrSourceHostRateLimited) {
+				rateLimited[host] = true
+				continue
+			}
+			applySourceRepository(stats, repository, err)
+		}
+		if err := uc.StatsRepo.SaveSourceRepoStats(stats); err != nil {
+			errs = append(errs, fmt.Errorf("project %s: %w", project.ID, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// sourceStatsFor prepares the statistics of a project's source link for a new check, keeping
+// what was fetched before for the same link, and finds the host serving it. host is nil when
+// no configured host serves the link.
+func (uc *SourceStatsUseCase) sourceStatsFor(project *domain.Project) (stats *domain.SourceRepoStats, host SourceHost, repositoryPath string) {
+	stats = &domain.SourceRepoStats{ProjectID: project.ID, SourceLink: project.SourceLink}
+	if current := project.CurrentSourceStats(); current != nil {
+		stats = current
+	}
+	stats.CheckedAt = time.Now()
+	stats.LastError = ""
+
+	link, err := url.Parse(strings.TrimSpace(project.SourceLink))
+	if err != nil || link.Host == "" {
+		stats.LastError = "not a valid repository link"
+		return stats, nil, ""
+	}
+	stats.Host = strings.ToLower(link.Hostname())
+	for _, candidate := range uc.Hosts {
+		if repositoryPath = candidate.RepositoryPath(link); repositoryPath != "" {
+			return stats, candidate, repositoryPath
+		}
+	}
+	stats.LastError = "not on a supported code host"
+	return stats, nil, ""
+}
+
+// applySourceRepository records the outcome of a fetch in the statistics of a source link.
+func applySourceRepository(stats *domain.SourceRepoStats, repository *SourceRepository, err error) {
+	switch {
+	case err == nil:
+		stats.Stars = repository.Stars
+		stats.LastCommitAt = repository.LastCommitAt
+		stats.License = repository.License
+		stats.Archived = repository.Archived
+		stats.NotFound = false
+	case errors.Is(err, ErrSourceRepositoryNotFound):
+		stats.NotFound = true
+	default:
+		message := err.Error()
+		if len(message) > maxSourceStatsErrorLength {
+			message = message[:maxSourceStatsErrorLength]
+		}
+		stats.LastError = strings.ToValidUTF8(message, "")
+		return
+	}
+	syncedAt := stats.CheckedAt
+	stats.SyncedAt = &syncedAt
+}
@@ -0,0 +1,29 @@
+package application
+
+import (
+	"devsearch-go/internal/domain"
+
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// TrashRepository defines the interface for data operations on deleted projects, skills and
+// profiles, which stay in the trash until they are restored or purged.
+type TrashRepository interface {
+	DeleteProfile(profile *domain.Profile) error
+	FindDeletedProjects(ownerUserID uuid.UUID) ([]domain.Project, error)
+	FindDeletedSkills(ownerProfileID uuid.UUID) ([]domain.Skill, error)
+	FindDeletedProjectByID(id uuid.UUID) (*domain.Project, error)
+	FindDeletedSkillByID(id uuid.UUID) (*domain.Skill, error)
+	FindDeletedProfileByUserID(userID uuid.UUID) (*domain.Profile, error)
+	RestoreProject(id uuid.UUID, slug string) error
+	RestoreSkill(id uuid.UUID) error
+	RestoreProfile(profile *domain.Profile) error
+	FindExpiredProjects(deletedBefore time.Time, limit int) ([]domain.Project, error)
+	FindExpiredSkills(deletedBefore time.Time, limit int) ([]domain.Skill, error)
+	FindExpiredProfiles(deletedBefore time.Time, limit int) ([]domain.Profile, error)
+	PurgeProject(project *domain.Project) (revisionImages []string, err error)
+	PurgeSkill(id uuid.UUID) error
+	PurgeProfile(profile *domain.Profile) (reviewedProjectIDs []uuid.UUID, err error)
+}
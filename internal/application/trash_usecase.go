@@ -0,0 +1,286 @@
+package application
+
+import (
+	"devsearch-go/internal/domain"
+
+	"errors"
+	"fmt"
+	"log"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// TrashRetention is how long deleted projects, skills and accounts can be restored before
+// they are purged for good.
+const TrashRetention = 30 * 24 * time.Hour
+
+// trashPurgeBatchSize is the number of expired items of each kind purged per query.
+const trashPurgeBatchSize = 100
+
+// Kinds of items in the trash.
+const (
+	TrashKindProject = "project"
+	TrashKindSkill   = "skill"
+)
+
+var (
+	// ErrTrashItemNotFound is returned for items that are not in the user's trash.
+	ErrTrashItemNotFound = errors.New("item not found in the trash")
+	// ErrTrashItemExpired is returned for items kept longer than TrashRetention, which are about to be purged.
+	ErrTrashItemExpired = errors.New("this item was deleted too long ago to be restored")
+)
+
+// TrashEntry is a deleted project or skill that its owner can still restore.
+type TrashEntry struct {
+	Kind      string
+	ID        uuid.UUID
+	Title     string
+	DeletedAt time.Time
+	PurgeAt   time.Time
+}
+
+// TrashUseCase defines the business logic for restoring deleted projects and skills and for
+// purging everything kept in the trash longer than TrashRetention.
+type TrashUseCase struct {
+	TrashRepo   TrashRepository
+	ProjectRepo ProjectRepository
+	ProfileRepo ProfileRepository
+	SlugRepo    SlugRedirectRepository
+	AuditRepo   AuditRepository
+	Media       MediaStore
+}
+
+// NewTrashUseCase creates a new TrashUseCase.
+func NewTrashUseCase(trashRepo TrashRepository, projectRepo ProjectRepository, profileRepo ProfileRepository, slugRepo SlugRedirectRepository, auditRepo AuditRepository, media MediaStore) *TrashUseCase {
+	return &TrashUseCase{
+		TrashRepo:   trashRepo,
+		ProjectRepo: projectRepo,
+		ProfileRepo: profileRepo,
+		SlugRepo:    slugRepo,
+		AuditRepo:   auditRepo,
+		Media:       media,
+	}
+}
+
+// GetTrash lists the user's deleted projects and skills that can still be restored, most
+// recently deleted first.
+func (uc *TrashUseCase) GetTrash(userID uuid.UUID) ([]TrashEntry, error) {
+	principal, err := loadPrincipal(uc.ProfileRepo, userID)
+	if err != nil {
+		return nil, err
+	}
+	projects, err := uc.TrashRepo.FindDeletedProjects(userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load deleted projects: %w", err)
+	}
+	skills, err := uc.TrashRepo.FindDeletedSkills(principal.ProfileID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load deleted skills: %w", err)
+	}
+
+	var entries []TrashEntry
+	for _, project := range projects {
+		if trashExpired(project.DeletedAt.Time) {
+			continue
+		}
+		entries = append(entries, trashEntry(TrashKindProject, project.ID, project.Title, project.DeletedAt.Time))
+	}
+	for _, skill := range skills {
+		if trashExpired(skill.DeletedAt.Time) {
+			continue
+		}
+		entries = append(entries, trashEntry(TrashKindSkill, skill.ID, skill.Name, skill.DeletedAt.Time))
+	}
+	sort.Slice(entries, func(i, j int) bool { return entries[i].DeletedAt.After(entries[j].DeletedAt) })
+	return entries, nil
+}
+
+// RestoreProject takes a project the user deleted out of the trash. When another project took
+// its slug in the meantime, the restored project gets a new one.
+func (uc *TrashUseCase) RestoreProject(userID, projectID uuid.UUID, meta RequestMeta) (*domain.Project, error) {
+	project, err := uc.TrashRepo.FindDeletedProjectByID(projectID)
+	if err != nil || !Can(Principal{UserID: userID}, ActionDelete, project) {
+		return nil, ErrTrashItemNotFound
+	}
+	if trashExpired(project.DeletedAt.Time) {
+		return project, ErrTrashItemExpired
+	}
+
+	// Slugs are only unique outside the trash, so the project gets a new one before it comes
+	// back if another project took its slug in the meantime.
+	if uc.slugTakenByOther(project) {
+		project.Slug = fmt.Sprintf("%s-%s", project.Slug, project.ID.String()[:8])
+	}
+	if err := uc.TrashRepo.RestoreProject(project.ID, project.Slug); err != nil {
+		return project, fmt.Errorf("failed to restore project: %w", err)
+	}
+	project.DeletedAt.Valid = false
+
+	recordAudit(uc.AuditRepo, meta, &domain.AuditEvent{
+		ActorID:     auditActor(userID),
+		Action:      domain.AuditActionProjectRestore,
+		TargetKind:  domain.AuditTargetProject,
+		TargetID:    &project.ID,
+		TargetLabel: project.Title,
+	})
+	return project, nil
+}
+
+// slugTakenByOther reports whether another project took the slug of a restored project, or
+// redirects from it, while it was in the trash.
+func (uc *TrashUseCase) slugTakenByOther(project *domain.Project) bool {
+	if existing, err := uc.ProjectRepo.FindProjectBySlug(project.Slug); err == nil && existing.ID != project.ID {
+		return true
+	}
+	if redirect, err := uc.SlugRepo.FindSlugRedirect(domain.SlugKindProject, project.Slug); err == nil && redirect.TargetID != project.ID {
+		return true
+	}
+	return false
+}
+
+// RestoreSkill takes a skill the user deleted out of the trash.
+func (uc *TrashUseCase) RestoreSkill(userID, skillID uuid.UUID) (*domain.Skill, error) {
+	principal, err := loadPrincipal(uc.ProfileRepo, userID)
+	if err != nil {
+		return nil, err
+	}
+	skill, err := uc.TrashRepo.FindDeletedSkillByID(skillID)
+	if err != nil || !Can(principal, ActionDelete, skill) {
+		return nil, ErrTrashItemNotFound
+	}
+	if trashExpired(skill.DeletedAt.Time) {
+		return skill, ErrTrashItemExpired
+	}
+
+	if err := uc.TrashRepo.RestoreSkill(skill.ID); err != nil {
+		return skill, fmt.Errorf("failed to restore skill: %w", err)
+	}
+	skill.DeletedAt.Valid = false
+	return skill, nil
+}
+
+// PurgeExpired permanently deletes the projects, skills and accounts kept in the trash longer
+// than TrashRetention, with their associations and uploaded images. It is run by a background job.
+func (uc *TrashUseCase) PurgeExpired() error {
+	deletedBefore := time.Now().Add(-TrashRetention)
+
+	for {
+		projects, err := uc.TrashRepo.FindExpiredProjects(deletedBefore, trashPurgeBatchSize)
+		if err != nil {
+			return fmt.Errorf("failed to load expired projects: %w", err)
+		}
+		for i := range projects {
+			if err := uc.purgeProject(&projects[i]); err != nil {
+				return err
+			}
+		}
+		if len(projects) < trashPurgeBatchSize {
+			break
+		}
+	}
+
+	for {
+		skills, err := uc.TrashRepo.FindExpiredSkills(deletedBefore, trashPurgeBatchSize)
+		if err != nil {
+			return fmt.Errorf("failed to load expired skills: %w", err)
+		}
+		for _, skill := range skills {
+			if err := uc.TrashRepo.PurgeSkill(skill.ID); err != nil {
+				return fmt.Errorf("failed to purge skill %s: %w", skill.ID, err)
+			}
+		}
+		if len(skills) < trashPurgeBatchSize {
+			break
+		}
+	}
+
+	for {
+		profiles, err := uc.TrashRepo.FindExpiredProfiles(deletedBefore, trashPurgeBatchSize)
+		if err != nil {
+			return fmt.Errorf("failed to load expired accounts: %w", err)
+		}
+		for i := range profiles {
+			if err := uc.purgeProfile(&profiles[i]); err != nil {
+				return err
+			}
+		}
+		if len(profiles) < trashPurgeBatchSize {
+			return nil
+		}
+	}
+}
+
+// purgeProject permanently deletes a project and then its featured image, including the ones
+// only earlier revisions refer to.
+func (uc *TrashUseCase) purgeProject(project *domain.Project) error {
+	revisionImages, err := uc.TrashRepo.PurgeProject(project)
+	if err != nil {
+		return fmt.Errorf("failed to purge project %s: %w", project.ID, err)
+	}
+	uc.deleteMedia(project.FeaturedImage)
+	for _, image := range revisionImages {
+		if image != project.FeaturedImage {
+			uc.deleteMedia(image)
+		}
+	}
+	return nil
+}
+
+// purgeProfile permanently deletes an account: the projects and skills deleted with it, the
+// profile and user with everything that refers to them, and the profile image. Votes of the
+// projects the user reviewed are recounted without their reviews.
+func (uc *TrashUseCase) purgeProfile(profile *domain.Profile) error {
+	projects, err := uc.TrashRepo.FindDeletedProjects(profile.UserID)
+	if err != nil {
+		return fmt.Errorf("failed to load projects of account %s: %w", profile.ID, err)
+	}
+	for i := range projects {
+		if err := uc.purgeProject(&projects[i]); err != nil {
+			return err
+		}
+	}
+	skills, err := uc.TrashRepo.FindDeletedSkills(profile.ID)
+	if err != nil {
+		return fmt.Errorf("failed to load skills of account %s: %w", profile.ID, err)
+	}
+	for _, skill := range skills {
+		if err := uc.TrashRepo.PurgeSkill(skill.ID); err != nil {
+			return fmt.Errorf("failed to purge skill %s: %w", skill.ID, err)
+		}
+	}
+
+	reviewedProjectIDs, err := uc.TrashRepo.PurgeProfile(profile)
+	if err != nil {
+		return fmt.Errorf("failed to purge account %s: %w", profile.ID, err)
+	}
+	uc.deleteMedia(profile.ProfileImage)
+	for _, projectID := range reviewedProjectIDs {
+		if err := recountProjectVotes(uc.ProjectRepo, &domain.Project{ID: projectID}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// deleteMedia removes an uploaded image. The rows referring to it are gone already, so a file
+// that cannot be removed is only logged.
+func (uc *TrashUseCase) deleteMedia(name string) {
+	if !isUploadedMedia(name) {
+		return
+	}
+	if err := uc.Media.DeleteMedia(name); err != nil {
+		log.Printf("Failed to delete media %s: %v", name, err)
+	}
+}
+
+// trashEntry builds the trash entry of an item deleted at deletedAt.
+func trashEntry(kind string, id uuid.UUID, title string, deletedAt time.Time) TrashEntry {
+	return TrashEntry{Kind: kind, ID: id, Title: title, DeletedAt: deletedAt, PurgeAt: deletedAt.Add(TrashRetention)}
+}
+
+// trashExpired reports whether an item deleted at deletedAt is past TrashRetention.
+func trashExpired(deletedAt time.Time) bool {
+	return time.Since(deletedAt) > TrashRetention
+}
@@ -21,7 +21,8 @@ type ProfileRepository interface {
 	CreateProfile(profile *domain.Profile) error
 	FindProfileByID(id uuid.UUID) (*domain.Profile, error)
 	FindProfileByUserID(userID uuid.UUID) (*domain.Profile, error)
-	FindAllProfiles(searchQuery string, page, limit int) ([]domain.Profile, int64, error)
+	FindProfileByUsername(username string) (*domain.Profile, error)
+	FindAllProfiles(filter ProfileFilter, page, limit int) ([]domain.Profile, int64, error)
 	UpdateProfile(profile *domain.Profile) error
 }
 
@@ -29,7 +30,6 @@ type ProfileRepository interface {
 type SkillRepository interface {
 	CreateSkill(skill *domain.Skill) error
 	FindSkillByID(id uuid.UUID) (*domain.Skill, error)
-	FindUserSkill(skillID, userID uuid.UUID) (*domain.Skill, error)
 	UpdateSkill(skill *domain.Skill) error
 	DeleteSkill(id uuid.UUID) error
 }
@@ -38,7 +38,7 @@ type SkillRepository interface {
 type MessageRepository interface {
 	CreateMessage(message *domain.Message) error
 	FindMessagesByRecipientID(recipientID uuid.UUID) ([]domain.Message, error)
-	FindMessageByIDAndRecipientID(messageID, recipientID uuid.UUID) (*domain.Message, error)
+	FindMessageByID(id uuid.UUID) (*domain.Message, error)
 	UpdateMessage(message *domain.Message) error
 	GetUnreadMessageCount(recipientID uuid.UUID) (int64, error)
 }
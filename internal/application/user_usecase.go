@@ -3,32 +3,66 @@ package application
 import (
 	"devsearch-go/internal/domain"
 
+	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/google/uuid"
 	"golang.org/x/crypto/bcrypt"
 )
 
+var (
+	// ErrInvalidUsername is returned for usernames that cannot be used in a profile URL.
+	ErrInvalidUsername = errors.New("username must be 3 to 30 letters, digits, dots, dashes or underscores")
+	// ErrUsernameTaken is returned when another developer uses, or used, the username.
+	ErrUsernameTaken = errors.New("username is already taken")
+	// ErrSkillNotFound is returned for skills that do not exist or belong to another developer.
+	ErrSkillNotFound = errors.New("skill not found")
+	// ErrMessageNotFound is returned for messages that do not exist or were sent to someone else.
+	ErrMessageNotFound = errors.New("message not found")
+	// ErrAccountSuspended is returned when a suspended user tries to log in.
+	ErrAccountSuspended = errors.New("this account has been suspended")
+	// ErrAccountDeleted is returned when a user whose account was deleted longer than TrashRetention ago tries to log in.
+	ErrAccountDeleted = errors.New("this account has been deleted")
+)
+
 // UserUseCase defines the business logic for users and profiles.
 type UserUseCase struct {
-	UserRepo    UserRepository
-	ProfileRepo ProfileRepository
-	SkillRepo   SkillRepository
-	MessageRepo MessageRepository
+	UserRepo     UserRepository
+	ProfileRepo  ProfileRepository
+	SkillRepo    SkillRepository
+	MessageRepo  MessageRepository
+	CatalogRepo  SkillCatalogRepository
+	SlugRepo     SlugRedirectRepository
+	ActivityRepo ActivityRepository
+	AuditRepo    AuditRepository
+	TrashRepo    TrashRepository
 }
 
 // NewUserUseCase creates a new UserUseCase.
-func NewUserUseCase(userRepo UserRepository, profileRepo ProfileRepository, skillRepo SkillRepository, messageRepo MessageRepository) *UserUseCase {
+func NewUserUseCase(userRepo UserRepository, profileRepo ProfileRepository, skillRepo SkillRepository, messageRepo MessageRepository, catalogRepo SkillCatalogRepository, slugRepo SlugRedirectRepository, activityRepo ActivityRepository, auditRepo AuditRepository, trashRepo TrashRepository) *UserUseCase {
 	return &UserUseCase{
-		UserRepo:    userRepo,
-		ProfileRepo: profileRepo,
-		SkillRepo:   skillRepo,
-		MessageRepo: messageRepo,
+		UserRepo:     userRepo,
+		ProfileRepo:  profileRepo,
+		SkillRepo:    skillRepo,
+		MessageRepo:  messageRepo,
+		CatalogRepo:  catalogRepo,
+		SlugRepo:     slugRepo,
+		ActivityRepo: activityRepo,
+		AuditRepo:    auditRepo,
+		TrashRepo:    trashRepo,
 	}
 }
 
 // RegisterUser registers a new user and creates their profile.
-func (uc *UserUseCase) RegisterUser(username, email, password string) (*domain.User, *domain.Profile, error) {
+func (uc *UserUseCase) RegisterUser(username, email, password string, meta RequestMeta) (*domain.User, *domain.Profile, error) {
+	if !domain.IsValidUsername(username) {
+		return nil, nil, ErrInvalidUsername
+	}
+	if uc.usernameTaken(username, uuid.Nil) {
+		return nil, nil, ErrUsernameTaken
+	}
+
 	// Check if username or email already exists
 	if existingUser, err := uc.UserRepo.FindUserByUsernameOrEmail(username, email); err == nil && existingUser != nil {
 		return nil, nil, fmt.Errorf("username or email already exists")
@@ -61,23 +95,111 @@ func (uc *UserUseCase) RegisterUser(username, email, password string) (*domain.U
 		return nil, nil, fmt.Errorf("failed to create user profile: %w", err)
 	}
 
+	uc.recordAccountAudit(domain.AuditActionRegister, &user, "", meta)
 	return &user, &profile, nil
 }
 
-// LoginUser authenticates a user.
-func (uc *UserUseCase) LoginUser(username, password string) (*domain.User, error) {
+// LoginUser authenticates a user. Successful and failed attempts are recorded in the audit log.
+// Logging in to an account deleted less than TrashRetention ago restores it.
+func (uc *UserUseCase) LoginUser(username, password string, meta RequestMeta) (*domain.User, error) {
 	user, err := uc.UserRepo.FindUserByUsername(username)
 	if err != nil {
+		recordAudit(uc.AuditRepo, meta, &domain.AuditEvent{
+			Action:      domain.AuditActionLoginFailed,
+			TargetKind:  domain.AuditTargetUser,
+			TargetLabel: username,
+		})
 		return nil, fmt.Errorf("username or password is incorrect")
 	}
 
 	if err := bcrypt.CompareHashAndPassword([]byte(user.Password), []byte(password)); err != nil {
+		uc.recordLoginFailure(user, meta)
 		return nil, fmt.Errorf("username or password is incorrect")
 	}
+	if user.IsSuspended() {
+		uc.recordLoginFailure(user, meta)
+		return nil, ErrAccountSuspended
+	}
+	if _, err := uc.ProfileRepo.FindProfileByUserID(user.ID); err != nil {
+		if err := uc.restoreAccount(user, meta); err != nil {
+			uc.recordLoginFailure(user, meta)
+			return nil, err
+		}
+	}
 
+	uc.recordAccountAudit(domain.AuditActionLogin, user, "", meta)
 	return user, nil
 }
 
+// LogoutUser records that the user signed out.
+func (uc *UserUseCase) LogoutUser(userID uuid.UUID, meta RequestMeta) {
+	user, err := uc.UserRepo.FindUserByID(userID)
+	if err != nil {
+		return
+	}
+	uc.recordAccountAudit(domain.AuditActionLogout, user, "", meta)
+}
+
+// DeleteAccount moves the user's profile to the trash together with their projects and skills,
+// which takes them out of every listing. Logging in within TrashRetention restores the account;
+// after that it is purged with everything that refers to it.
+func (uc *UserUseCase) DeleteAccount(userID uuid.UUID, meta RequestMeta) error {
+	user, err := uc.UserRepo.FindUserByID(userID)
+	if err != nil {
+		return ErrUserNotFound
+	}
+	profile, err := uc.ProfileRepo.FindProfileByUserID(userID)
+	if err != nil {
+		return fmt.Errorf("profile not found")
+	}
+	if err := uc.TrashRepo.DeleteProfile(profile); err != nil {
+		return fmt.Errorf("failed to delete account: %w", err)
+	}
+	uc.recordAccountAudit(domain.AuditActionAccountDelete, user, "", meta)
+	return nil
+}
+
+// restoreAccount takes the profile of a user who logs in again out of the trash, with the
+// projects and skills deleted together with it.
+func (uc *UserUseCase) restoreAccount(user *domain.User, meta RequestMeta) error {
+	profile, err := uc.TrashRepo.FindDeletedProfileByUserID(user.ID)
+	if err != nil {
+		return fmt.Errorf("profile not found")
+	}
+	if trashExpired(profile.DeletedAt.Time) {
+		return ErrAccountDeleted
+	}
+	if err := uc.TrashRepo.RestoreProfile(profile); err != nil {
+		return fmt.Errorf("failed to restore account: %w", err)
+	}
+	uc.recordAccountAudit(domain.AuditActionAccountRestore, user, "", meta)
+	return nil
+}
+
+// recordLoginFailure records a failed login to an existing account. The visitor is anonymous,
+// so the account is the target rather than the actor.
+func (uc *UserUseCase) recordLoginFailure(user *domain.User, meta RequestMeta) {
+	recordAudit(uc.AuditRepo, meta, &domain.AuditEvent{
+		Action:      domain.AuditActionLoginFailed,
+		TargetKind:  domain.AuditTargetUser,
+		TargetID:    &user.ID,
+		TargetLabel: user.Username,
+	})
+}
+
+// recordAccountAudit records an action of a user on their own account in the audit log.
+func (uc *UserUseCase) recordAccountAudit(action string, user *domain.User, changes string, meta RequestMeta) {
+	recordAudit(uc.AuditRepo, meta, &domain.AuditEvent{
+		ActorID:     auditActor(user.ID),
+		ActorName:   user.Username,
+		Action:      action,
+		TargetKind:  domain.AuditTargetUser,
+		TargetID:    &user.ID,
+		TargetLabel: user.Username,
+		Changes:     changes,
+	})
+}
+
 // GetUserAccount retrieves the authenticated user's account details.
 func (uc *UserUseCase) GetUserAccount(userID uuid.UUID) (*domain.User, error) {
 	user, err := uc.UserRepo.FindUserByID(userID)
@@ -88,7 +210,7 @@ func (uc *UserUseCase) GetUserAccount(userID uuid.UUID) (*domain.User, error) {
 }
 
 // UpdateUserAccount updates the authenticated user's account details.
-func (uc *UserUseCase) UpdateUserAccount(userID uuid.UUID, profileData map[string]string, profileImage string) (*domain.Profile, error) {
+func (uc *UserUseCase) UpdateUserAccount(userID uuid.UUID, profileData map[string]string, profileImage string, meta RequestMeta) (*domain.Profile, error) {
 	user, err := uc.UserRepo.FindUserByID(userID)
 	if err != nil {
 		return nil, fmt.Errorf("user not found")
@@ -98,6 +220,17 @@ func (uc *UserUseCase) UpdateUserAccount(userID uuid.UUID, profileData map[strin
 	if err != nil {
 		return nil, fmt.Errorf("profile not found")
 	}
+	before := *profile
+
+	previousUsername := profile.Username
+	if username := profileData["username"]; !strings.EqualFold(username, previousUsername) {
+		if !domain.IsValidUsername(username) {
+			return nil, ErrInvalidUsername
+		}
+		if uc.usernameTaken(username, profile.ID) {
+			return nil, ErrUsernameTaken
+		}
+	}
 
 	// Update profile fields
 	profile.Name = profileData["name"]
@@ -106,7 +239,11 @@ func (uc *UserUseCase) UpdateUserAccount(userID uuid.UUID, profileData map[strin
 	profile.ShortIntro = profileData["short_intro"]
 	profile.Bio = profileData["bio"]
 	profile.SocialGithub = profileData["social_github"]
+	profile.SocialLinkedin = profileData["social_linkedin"]
 	profile.SocialWebsite = profileData["social_website"]
+	if err := normalizeProfileLinks(profile); err != nil {
+		return nil, err
+	}
 	if profileImage != "" {
 		profile.ProfileImage = profileImage
 	}
@@ -123,37 +260,151 @@ func (uc *UserUseCase) UpdateUserAccount(userID uuid.UUID, profileData map[strin
 		}
 	}
 
+	// Keep the old profile URL working after a rename
+	if previousUsername != "" && !strings.EqualFold(previousUsername, profile.Username) {
+		redirect := domain.SlugRedirect{Kind: domain.SlugKindProfile, Slug: strings.ToLower(previousUsername), TargetID: profile.ID}
+		if err := uc.SlugRepo.SaveSlugRedirect(&redirect); err != nil {
+			return nil, fmt.Errorf("failed to keep old profile URL: %w", err)
+		}
+	}
+
+	uc.recordAccountAudit(domain.AuditActionAccountUpdate, user, auditChanges(&before, profile), meta)
+	return profile, nil
+}
+
+// GetProfileByUsername retrieves a profile by its username or by a username it had before a rename.
+// moved reports that the username is an old one and callers should redirect to the profile's URL.
+func (uc *UserUseCase) GetProfileByUsername(username string) (profile *domain.Profile, moved bool, err error) {
+	if profile, err = uc.ProfileRepo.FindProfileByUsername(username); err == nil {
+		return profile, false, nil
+	}
+
+	redirect, err := uc.SlugRepo.FindSlugRedirect(domain.SlugKindProfile, strings.ToLower(username))
+	if err != nil {
+		return nil, false, fmt.Errorf("profile not found: %w", err)
+	}
+	profile, err = uc.ProfileRepo.FindProfileByID(redirect.TargetID)
+	if err != nil {
+		return nil, false, fmt.Errorf("profile not found: %w", err)
+	}
+	return profile, true, nil
+}
+
+// usernameTaken reports whether a username is used, or was used before a rename, by another profile.
+func (uc *UserUseCase) usernameTaken(username string, profileID uuid.UUID) bool {
+	if existing, err := uc.ProfileRepo.FindProfileByUsername(username); err == nil && existing.ID != profileID {
+		return true
+	}
+	if redirect, err := uc.SlugRepo.FindSlugRedirect(domain.SlugKindProfile, strings.ToLower(username)); err == nil && redirect.TargetID != profileID {
+		return true
+	}
+	return false
+}
+
+// GetVisibleProfile retrieves a profile as seen by the viewer, with the fields they may not see cleared.
+// uuid.Nil identifies an anonymous visitor.
+func (uc *UserUseCase) GetVisibleProfile(profileID, viewerID uuid.UUID) (*domain.Profile, error) {
+	profile, err := uc.ProfileRepo.FindProfileByID(profileID)
+	if err != nil {
+		return nil, err
+	}
+	if err := applyProfilePrivacy(profile, viewerID); err != nil {
+		return nil, err
+	}
+	return profile, nil
+}
+
+// UpdatePrivacy replaces the authenticated user's profile privacy settings.
+func (uc *UserUseCase) UpdatePrivacy(userID uuid.UUID, privacy domain.ProfilePrivacy) (*domain.Profile, error) {
+	if err := validateProfilePrivacy(&privacy); err != nil {
+		return nil, err
+	}
+
+	profile, err := uc.ProfileRepo.FindProfileByUserID(userID)
+	if err != nil {
+		return nil, fmt.Errorf("profile not found")
+	}
+
+	profile.Privacy = privacy
+	if err := uc.ProfileRepo.UpdateProfile(profile); err != nil {
+		return nil, fmt.Errorf("failed to update privacy settings: %w", err)
+	}
+	return profile, nil
+}
+
+// UpdateJobPreferences replaces the authenticated user's availability and job-seeking preferences.
+func (uc *UserUseCase) UpdateJobPreferences(userID uuid.UUID, preferences domain.JobPreferences) (*domain.Profile, error) {
+	if err := validateJobPreferences(&preferences); err != nil {
+		return nil, err
+	}
+
+	profile, err := uc.ProfileRepo.FindProfileByUserID(userID)
+	if err != nil {
+		return nil, fmt.Errorf("profile not found")
+	}
+
+	profile.JobPreferences = preferences
+	if err := uc.ProfileRepo.UpdateProfile(profile); err != nil {
+		return nil, fmt.Errorf("failed to update job preferences: %w", err)
+	}
 	return profile, nil
 }
 
-// CreateSkill creates a new skill for a user.
-func (uc *UserUseCase) CreateSkill(userID uuid.UUID, name, description string) (*domain.Skill, error) {
+// CreateSkill creates a new skill for a user, linked to the matching catalog skill.
+func (uc *UserUseCase) CreateSkill(userID uuid.UUID, name, description, level string, years int) (*domain.Skill, error) {
+	if err := validateSkillProficiency(level, years); err != nil {
+		return nil, err
+	}
+
 	profile, err := uc.ProfileRepo.FindProfileByUserID(userID)
 	if err != nil {
 		return nil, fmt.Errorf("profile not found for user: %w", err)
 	}
 
 	skill := domain.Skill{
-		OwnerID:     profile.ID,
-		Name:        name,
-		Description: description,
+		OwnerID:           profile.ID,
+		Name:              name,
+		Description:       description,
+		Level:             level,
+		YearsOfExperience: years,
+	}
+	if err := resolveCatalogSkill(uc.CatalogRepo, &skill); err != nil {
+		return nil, err
 	}
 
 	if err := uc.SkillRepo.CreateSkill(&skill); err != nil {
 		return nil, fmt.Errorf("failed to create skill: %w", err)
 	}
+
+	recordActivity(uc.ActivityRepo, &domain.ActivityEvent{
+		Kind:      domain.ActivitySkillAdded,
+		ActorID:   profile.ID,
+		SubjectID: skill.ID,
+		Title:     skill.Name,
+		Detail:    skill.Level,
+		URL:       profile.URL(),
+	})
 	return &skill, nil
 }
 
-// UpdateSkill updates an existing skill.
-func (uc *UserUseCase) UpdateSkill(skillID, userID uuid.UUID, name, description string) (*domain.Skill, error) {
-	skill, err := uc.SkillRepo.FindUserSkill(skillID, userID)
+// UpdateSkill updates an existing skill, relinking it to the catalog skill matching its name.
+func (uc *UserUseCase) UpdateSkill(skillID, userID uuid.UUID, name, description, level string, years int) (*domain.Skill, error) {
+	if err := validateSkillProficiency(level, years); err != nil {
+		return nil, err
+	}
+
+	skill, err := uc.loadOwnSkill(userID, skillID, ActionEdit)
 	if err != nil {
-		return nil, fmt.Errorf("skill not found or unauthorized: %w", err)
+		return nil, err
 	}
 
 	skill.Name = name
 	skill.Description = description
+	skill.Level = level
+	skill.YearsOfExperience = years
+	if err := resolveCatalogSkill(uc.CatalogRepo, skill); err != nil {
+		return nil, err
+	}
 
 	if err := uc.SkillRepo.UpdateSkill(skill); err != nil {
 		return nil, fmt.Errorf("failed to update skill: %w", err)
@@ -161,19 +412,33 @@ func (uc *UserUseCase) UpdateSkill(skillID, userID uuid.UUID, name, description
 	return skill, nil
 }
 
-// DeleteSkill deletes a skill.
+// DeleteSkill moves a skill owned by the user to the trash, from which they can restore it within TrashRetention.
 func (uc *UserUseCase) DeleteSkill(skillID, userID uuid.UUID) error {
-	skill, err := uc.SkillRepo.FindUserSkill(skillID, userID)
+	skill, err := uc.loadOwnSkill(userID, skillID, ActionDelete)
 	if err != nil {
-		return fmt.Errorf("skill not found or unauthorized: %w", err)
+		return err
 	}
 
 	return uc.SkillRepo.DeleteSkill(skill.ID)
 }
 
-// GetSkillByID retrieves a single skill by ID.
-func (uc *UserUseCase) GetSkillByID(id uuid.UUID) (*domain.Skill, error) {
-	return uc.SkillRepo.FindSkillByID(id)
+// GetUserSkill retrieves a skill owned by the user.
+func (uc *UserUseCase) GetUserSkill(skillID, userID uuid.UUID) (*domain.Skill, error) {
+	return uc.loadOwnSkill(userID, skillID, ActionView)
+}
+
+// loadOwnSkill loads a skill the user may perform the action on, treating skills of other
+// developers as missing.
+func (uc *UserUseCase) loadOwnSkill(userID, skillID uuid.UUID, action Action) (*domain.Skill, error) {
+	principal, err := loadPrincipal(uc.ProfileRepo, userID)
+	if err != nil {
+		return nil, err
+	}
+	skill, err := uc.SkillRepo.FindSkillByID(skillID)
+	if err != nil || !Can(principal, action, skill) {
+		return nil, ErrSkillNotFound
+	}
+	return skill, nil
 }
 
 // GetInbox retrieves all messages for the authenticated user.
@@ -197,16 +462,16 @@ func (uc *UserUseCase) GetInbox(userID uuid.UUID) ([]domain.Message, int64, erro
 	return messages, unreadCount, nil
 }
 
-// GetMessage retrieves a single message by ID.
+// GetMessage retrieves a single message sent to the user by ID.
 func (uc *UserUseCase) GetMessage(messageID, userID uuid.UUID) (*domain.Message, error) {
-	profile, err := uc.ProfileRepo.FindProfileByUserID(userID)
+	principal, err := loadPrincipal(uc.ProfileRepo, userID)
 	if err != nil {
-		return nil, fmt.Errorf("profile not found for authenticated user: %w", err)
+		return nil, err
 	}
 
-	message, err := uc.MessageRepo.FindMessageByIDAndRecipientID(messageID, profile.ID)
-	if err != nil {
-		return nil, fmt.Errorf("message not found or unauthorized: %w", err)
+	message, err := uc.MessageRepo.FindMessageByID(messageID)
+	if err != nil || !Can(principal, ActionView, message) {
+		return nil, ErrMessageNotFound
 	}
 
 	// Mark message as read
@@ -260,12 +525,30 @@ func (uc *UserUseCase) CreateMessage(senderUserID *uuid.UUID, recipientID uuid.U
 	return nil
 }
 
-// GetAllProfiles retrieves all profiles with optional search and pagination.
-func (uc *UserUseCase) GetAllProfiles(searchQuery string, page, limit int) ([]domain.Profile, int64, error) {
-	return uc.ProfileRepo.FindAllProfiles(searchQuery, page, limit)
+// GetAllProfiles retrieves the listed profiles matching the filter, with pagination.
+// Fields the viewer may not see are cleared; uuid.Nil identifies an anonymous visitor.
+func (uc *UserUseCase) GetAllProfiles(filter ProfileFilter, viewerID uuid.UUID, page, limit int) ([]domain.Profile, int64, error) {
+	profiles, total, err := uc.ProfileRepo.FindAllProfiles(filter, page, limit)
+	if err != nil {
+		return nil, 0, err
+	}
+	for i := range profiles {
+		// Search only returns public profiles, so applying privacy cannot fail here.
+		_ = applyProfilePrivacy(&profiles[i], viewerID)
+	}
+	return profiles, total, nil
 }
 
 // GetProfileByID retrieves a single user profile by ID.
 func (uc *UserUseCase) GetProfileByID(id uuid.UUID) (*domain.Profile, error) {
 	return uc.ProfileRepo.FindProfileByID(id)
 }
+
+// GetProfileByUserID retrieves a user's profile with its skills, projects, experience and education.
+func (uc *UserUseCase) GetProfileByUserID(userID uuid.UUID) (*domain.Profile, error) {
+	profile, err := uc.ProfileRepo.FindProfileByUserID(userID)
+	if err != nil {
+		return nil, fmt.Errorf("profile not found for user: %w", err)
+	}
+	return uc.ProfileRepo.FindProfileByID(profile.ID)
+}
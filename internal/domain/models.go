@@ -1,24 +1,47 @@
 package domain
 
 import (
+	"encoding/json"
+	"errors"
+	"net/url"
+	"regexp"
+	"strings"
 	"time"
+	"unicode"
 
 	"github.com/google/uuid"
 	"golang.org/x/crypto/bcrypt"
 	"gorm.io/gorm"
 )
 
+// Roles a user can have. Moderators and admins are staff and can use the admin console.
+const (
+	UserRoleUser      = "user"
+	UserRoleModerator = "moderator"
+	UserRoleAdmin     = "admin"
+)
+
+// UserRoles lists the valid user roles, from least to most privileged.
+var UserRoles = []string{UserRoleUser, UserRoleModerator, UserRoleAdmin}
+
+// IsStaffRole reports whether the role is a moderator or an admin role.
+func IsStaffRole(role string) bool {
+	return role == UserRoleModerator || role == UserRoleAdmin
+}
+
 type User struct {
-	ID        uuid.UUID `gorm:"type:uuid;primaryKey;default:uuid_generate_v4()"`
-	Name      string    `gorm:"size:255;not null"`
-	Email     string    `gorm:"size:255;not null;unique"`
-	Username  string    `gorm:"size:255;not null;unique"`
-	Password  string    `gorm:"size:255;not null"`
-	CreatedAt time.Time
-	UpdatedAt time.Time
-	Profile   Profile   `gorm:"foreignKey:UserID"`
-	Projects  []Project `gorm:"foreignKey:OwnerID"`
-	Messages  []Message `gorm:"foreignKey:RecipientID"`
+	ID          uuid.UUID `gorm:"type:uuid;primaryKey;default:uuid_generate_v4()"`
+	Name        string    `gorm:"size:255;not null"`
+	Email       string    `gorm:"size:255;not null;unique" json:"-"` // Never serialised, profiles control email visibility
+	Username    string    `gorm:"size:255;not null;unique"`
+	Password    string    `gorm:"size:255;not null" json:"-"`
+	Role        string    `gorm:"size:20;not null;default:'user'"`
+	SuspendedAt *time.Time
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+	Profile     Profile   `gorm:"foreignKey:UserID"`
+	Projects    []Project `gorm:"foreignKey:OwnerID"`
+	Messages    []Message `gorm:"foreignKey:RecipientID"`
 }
 
 func (user *User) BeforeCreate(tx *gorm.DB) (err error) {
@@ -38,6 +61,16 @@ func (user *User) CheckPasswordHash(password string) bool {
 	return err == nil
 }
 
+// IsStaff reports whether the user is a moderator or an admin.
+func (user User) IsStaff() bool {
+	return IsStaffRole(user.Role)
+}
+
+// IsSuspended reports whether a moderator suspended the account.
+func (user User) IsSuspended() bool {
+	return user.SuspendedAt != nil
+}
+
 type Profile struct {
 	ID             uuid.UUID `gorm:"type:uuid;primaryKey;default:uuid_generate_v4()"`
 	UserID         uuid.UUID `gorm:"type:uuid;not null;unique"`
@@ -47,11 +80,18 @@ type Profile struct {
 	Location       string    `gorm:"size:255"`
 	ShortIntro     string    `gorm:"size:255"`
 	Bio            string
-	ProfileImage   string  `gorm:"size:255;default:'user-default.png'"`
-	SocialGithub   string  `gorm:"size:255"`
-	SocialLinkedin string  `gorm:"size:255"`
-	SocialWebsite  string  `gorm:"size:255"`
-	Skills         []Skill `gorm:"foreignKey:OwnerID"`
+	ProfileImage   string         `gorm:"size:255;default:'user-default.png'"`
+	SocialGithub   string         `gorm:"size:255"`
+	SocialLinkedin string         `gorm:"size:255"`
+	SocialWebsite  string         `gorm:"size:255"`
+	Skills         []Skill        `gorm:"foreignKey:OwnerID"`
+	Projects       []Project      `gorm:"foreignKey:OwnerID;references:UserID"`
+	Experiences    []Experience   `gorm:"foreignKey:OwnerID"`
+	Educations     []Education    `gorm:"foreignKey:OwnerID"`
+	JobPreferences JobPreferences `gorm:"embedded"`
+	Privacy        ProfilePrivacy `gorm:"embedded;embeddedPrefix:privacy_"`
+	HiddenAt       *time.Time     `gorm:"index"` // Set by moderation to take the profile out of search and recommendations
+	DeletedAt      gorm.DeletedAt `gorm:"index"` // Set while the profile is in the trash; GORM leaves it out of queries
 	CreatedAt      time.Time
 	UpdatedAt      time.Time
 }
@@ -63,22 +103,630 @@ func (profile *Profile) BeforeCreate(tx *gorm.DB) (err error) {
 	return
 }
 
+// URL returns the shareable path of the profile, falling back to its ID while it has no username.
+func (profile Profile) URL() string {
+	if profile.Username != "" {
+		return "/u/" + url.PathEscape(profile.Username)
+	}
+	return "/profile/" + profile.ID.String()
+}
+
+// IsHidden reports whether moderation hid the profile.
+func (profile Profile) IsHidden() bool {
+	return profile.HiddenAt != nil
+}
+
+// usernamePattern lists the characters allowed in usernames, which double as profile URLs.
+var usernamePattern = regexp.MustCompile(`^[a-zA-Z0-9_.-]{3,30}$`)
+
+// IsValidUsername reports whether a username can be used in a profile URL.
+func IsValidUsername(username string) bool {
+	return usernamePattern.MatchString(username)
+}
+
+// Availability statuses a developer can advertise on their profile.
+const (
+	AvailabilityOpen       = "open"
+	AvailabilityNotLooking = "not_looking"
+	AvailabilityFreelance  = "freelance"
+)
+
+// Availabilities lists the availability statuses.
+var Availabilities = []string{AvailabilityOpen, AvailabilityFreelance, AvailabilityNotLooking}
+
+// Work arrangements a developer can prefer.
+const (
+	WorkArrangementRemote = "remote"
+	WorkArrangementOnsite = "onsite"
+	WorkArrangementHybrid = "hybrid"
+)
+
+// WorkArrangements lists the work arrangements.
+var WorkArrangements = []string{WorkArrangementRemote, WorkArrangementHybrid, WorkArrangementOnsite}
+
+// Field visibility settings. VisibilityMembers limits a field to logged-in users.
+const (
+	VisibilityPublic  = "public"
+	VisibilityMembers = "members"
+	VisibilityHidden  = "hidden"
+)
+
+// Visibilities lists the field visibility settings from most to least open.
+var Visibilities = []string{VisibilityPublic, VisibilityMembers, VisibilityHidden}
+
+// Profile modes. Unlisted profiles are reachable by link but excluded from search,
+// private profiles are only visible to their owner.
+const (
+	ProfileModePublic   = "public"
+	ProfileModeUnlisted = "unlisted"
+	ProfileModePrivate  = "private"
+)
+
+// ProfileModes lists the profile modes.
+var ProfileModes = []string{ProfileModePublic, ProfileModeUnlisted, ProfileModePrivate}
+
+// ProfilePrivacy holds who may see a profile and its sensitive fields. It is stored in the profiles table.
+type ProfilePrivacy struct {
+	Mode        string `gorm:"size:32;default:'public';index"`
+	Email       string `gorm:"size:32;default:'hidden'"`
+	Location    string `gorm:"size:32;default:'public'"`
+	SocialLinks string `gorm:"size:32;default:'public'"`
+	Experiences string `gorm:"size:32;default:'public'"`
+	Educations  string `gorm:"size:32;default:'public'"`
+}
+
+// JobPreferences describes whether and how a developer wants to be hired. It is stored in the profiles table.
+type JobPreferences struct {
+	Availability     string `gorm:"size:32;index"`
+	PreferredRoles   string `gorm:"size:512"` // Comma separated
+	WorkArrangement  string `gorm:"size:32"`
+	SalaryMin        int    `gorm:"default:0"`
+	SalaryMax        int    `gorm:"default:0"`
+	SalaryCurrency   string `gorm:"size:3"`
+	SalaryVisibility string `gorm:"size:32;default:'hidden'"`
+	TimeZone         string `gorm:"size:64"`
+}
+
+// PreferredRoleList returns the comma separated preferred roles as a list.
+func (preferences JobPreferences) PreferredRoleList() []string {
+	var roles []string
+	for _, role := range strings.Split(preferences.PreferredRoles, ",") {
+		if role = strings.TrimSpace(role); role != "" {
+			roles = append(roles, role)
+		}
+	}
+	return roles
+}
+
+// HasSalary reports whether a salary expectation is set.
+func (preferences JobPreferences) HasSalary() bool {
+	return preferences.SalaryMin > 0 || preferences.SalaryMax > 0
+}
+
+// AvailabilityLabel returns a human readable availability status.
+func (preferences JobPreferences) AvailabilityLabel() string {
+	switch preferences.Availability {
+	case AvailabilityOpen:
+		return "Open to work"
+	case AvailabilityFreelance:
+		return "Available for freelance"
+	case AvailabilityNotLooking:
+		return "Not looking"
+	}
+	return ""
+}
+
 type Skill struct {
+	ID                uuid.UUID     `gorm:"type:uuid;primaryKey;default:uuid_generate_v4()"`
+	OwnerID           uuid.UUID     `gorm:"type:uuid;not null"`
+	CatalogSkill      *CatalogSkill `gorm:"foreignKey:CatalogSkillID"`
+	CatalogSkillID    *uuid.UUID    `gorm:"type:uuid;index"`
+	Name              string        `gorm:"size:255;not null"`
+	Description       string
+	Level             string         `gorm:"size:32"`
+	YearsOfExperience int            `gorm:"default:0"`
+	Endorsements      []Endorsement  `gorm:"foreignKey:SkillID;constraint:OnDelete:CASCADE"`
+	DeletedAt         gorm.DeletedAt `gorm:"index"` // Set while the skill is in the trash; GORM leaves it out of queries
+	CreatedAt         time.Time
+	UpdatedAt         time.Time
+}
+
+func (skill *Skill) BeforeCreate(tx *gorm.DB) (err error) {
+	if skill.ID == uuid.Nil {
+		skill.ID = uuid.New()
+	}
+	return
+}
+
+// IsEndorsedBy reports whether the user with the given ID endorsed the skill.
+// It relies on Endorsements and their Endorser being loaded.
+func (skill *Skill) IsEndorsedBy(userID uuid.UUID) bool {
+	for _, endorsement := range skill.Endorsements {
+		if endorsement.Endorser.UserID == userID {
+			return true
+		}
+	}
+	return false
+}
+
+// Endorsement is a developer vouching for a skill on another developer's profile.
+type Endorsement struct {
+	ID         uuid.UUID `gorm:"type:uuid;primaryKey;default:uuid_generate_v4()"`
+	SkillID    uuid.UUID `gorm:"type:uuid;not null;uniqueIndex:idx_endorsement_skill_endorser"`
+	Endorser   Profile   `gorm:"foreignKey:EndorserID;constraint:OnDelete:CASCADE"`
+	EndorserID uuid.UUID `gorm:"type:uuid;not null;uniqueIndex:idx_endorsement_skill_endorser"`
+	CreatedAt  time.Time
+}
+
+func (endorsement *Endorsement) BeforeCreate(tx *gorm.DB) (err error) {
+	if endorsement.ID == uuid.Nil {
+		endorsement.ID = uuid.New()
+	}
+	return
+}
+
+// Skill proficiency levels.
+const (
+	SkillLevelBeginner     = "beginner"
+	SkillLevelIntermediate = "intermediate"
+	SkillLevelAdvanced     = "advanced"
+	SkillLevelExpert       = "expert"
+)
+
+// SkillLevels lists the proficiency levels from lowest to highest.
+var SkillLevels = []string{SkillLevelBeginner, SkillLevelIntermediate, SkillLevelAdvanced, SkillLevelExpert}
+
+// IsValidSkillLevel reports whether level is empty or one of SkillLevels.
+func IsValidSkillLevel(level string) bool {
+	if level == "" {
+		return true
+	}
+	for _, known := range SkillLevels {
+		if known == level {
+			return true
+		}
+	}
+	return false
+}
+
+// CatalogSkill is a canonical skill that free-text profile skills are mapped to.
+type CatalogSkill struct {
+	ID        uuid.UUID    `gorm:"type:uuid;primaryKey;default:uuid_generate_v4()"`
+	Name      string       `gorm:"size:255;not null"`
+	Slug      string       `gorm:"size:255;not null;unique"`
+	Aliases   []SkillAlias `gorm:"foreignKey:CatalogSkillID"`
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+func (catalogSkill *CatalogSkill) BeforeCreate(tx *gorm.DB) (err error) {
+	if catalogSkill.ID == uuid.Nil {
+		catalogSkill.ID = uuid.New()
+	}
+	if catalogSkill.Slug == "" {
+		catalogSkill.Slug = NormalizeSkillName(catalogSkill.Name)
+	}
+	return
+}
+
+// SkillAlias is an alternative spelling of a catalog skill, stored normalized.
+type SkillAlias struct {
+	ID             uuid.UUID `gorm:"type:uuid;primaryKey;default:uuid_generate_v4()"`
+	CatalogSkillID uuid.UUID `gorm:"type:uuid;not null;index"`
+	Alias          string    `gorm:"size:255;not null;unique"`
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+func (alias *SkillAlias) BeforeCreate(tx *gorm.DB) (err error) {
+	if alias.ID == uuid.Nil {
+		alias.ID = uuid.New()
+	}
+	return
+}
+
+// NormalizeSkillName reduces a skill name to the key used to match catalog entries and
+// aliases: lower case letters and digits, keeping '#' and '+' so "C#" and "C++" stay apart.
+func NormalizeSkillName(name string) string {
+	var key strings.Builder
+	for _, r := range strings.ToLower(name) {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) || r == '#' || r == '+' {
+			key.WriteRune(r)
+		}
+	}
+	return key.String()
+}
+
+// maxSlugLength bounds the length of generated slugs.
+const maxSlugLength = 80
+
+// Slugify turns a title into a URL slug made of lower case letters, digits and dashes.
+func Slugify(title string) string {
+	var slug strings.Builder
+	dash := false
+	for _, r := range strings.ToLower(title) {
+		switch {
+		case unicode.IsLetter(r) || unicode.IsDigit(r):
+			if dash && slug.Len() > 0 {
+				slug.WriteByte('-')
+			}
+			dash = false
+			slug.WriteRune(r)
+		default:
+			dash = true
+		}
+		if slug.Len() >= maxSlugLength {
+			break
+		}
+	}
+	return strings.Trim(slug.String(), "-")
+}
+
+// Slug redirect kinds.
+const (
+	SlugKindProfile = "profile"
+	SlugKindProject = "project"
+)
+
+// SlugRedirect keeps an old username or project slug pointing at its target after a rename.
+type SlugRedirect struct {
+	ID        uuid.UUID `gorm:"type:uuid;primaryKey;default:uuid_generate_v4()"`
+	Kind      string    `gorm:"size:32;not null;uniqueIndex:idx_slug_redirect_kind_slug"`
+	Slug      string    `gorm:"size:255;not null;uniqueIndex:idx_slug_redirect_kind_slug"`
+	TargetID  uuid.UUID `gorm:"type:uuid;not null;index"`
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+func (redirect *SlugRedirect) BeforeCreate(tx *gorm.DB) (err error) {
+	if redirect.ID == uuid.Nil {
+		redirect.ID = uuid.New()
+	}
+	return
+}
+
+// Page view kinds.
+const (
+	ViewKindProfile = "profile"
+	ViewKindProject = "project"
+)
+
+// DirectReferrer is the referrer recorded for views without an external referring site.
+const DirectReferrer = "direct"
+
+// PageView is a raw view of a profile or project page, stored at most once per visitor per day.
+// Raw views are folded into ViewStat and ReferrerStat rows by the rollup job and purged afterwards.
+type PageView struct {
 	ID          uuid.UUID `gorm:"type:uuid;primaryKey;default:uuid_generate_v4()"`
-	OwnerID     uuid.UUID `gorm:"type:uuid;not null"`
-	Name        string    `gorm:"size:255;not null"`
-	Description string
+	Kind        string    `gorm:"size:32;not null;uniqueIndex:idx_page_view_visitor_day"`
+	TargetID    uuid.UUID `gorm:"type:uuid;not null;uniqueIndex:idx_page_view_visitor_day"`
+	VisitorHash string    `gorm:"size:64;not null;uniqueIndex:idx_page_view_visitor_day"`
+	Day         time.Time `gorm:"type:date;not null;uniqueIndex:idx_page_view_visitor_day;index"`
+	Referrer    string    `gorm:"size:255;not null"`
+	CreatedAt   time.Time
+}
+
+func (view *PageView) BeforeCreate(tx *gorm.DB) (err error) {
+	if view.ID == uuid.Nil {
+		view.ID = uuid.New()
+	}
+	return
+}
+
+// ViewStat is the number of unique daily visitors of a profile or project page.
+type ViewStat struct {
+	ID        uuid.UUID `gorm:"type:uuid;primaryKey;default:uuid_generate_v4()"`
+	Kind      string    `gorm:"size:32;not null;uniqueIndex:idx_view_stat_target_day"`
+	TargetID  uuid.UUID `gorm:"type:uuid;not null;uniqueIndex:idx_view_stat_target_day"`
+	Day       time.Time `gorm:"type:date;not null;uniqueIndex:idx_view_stat_target_day"`
+	Views     int       `gorm:"not null;default:0"`
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+func (stat *ViewStat) BeforeCreate(tx *gorm.DB) (err error) {
+	if stat.ID == uuid.Nil {
+		stat.ID = uuid.New()
+	}
+	return
+}
+
+// ReferrerStat is the number of unique daily visitors a referring site sent to a profile or project page.
+type ReferrerStat struct {
+	ID        uuid.UUID `gorm:"type:uuid;primaryKey;default:uuid_generate_v4()"`
+	Kind      string    `gorm:"size:32;not null;uniqueIndex:idx_referrer_stat_target_day"`
+	TargetID  uuid.UUID `gorm:"type:uuid;not null;uniqueIndex:idx_referrer_stat_target_day"`
+	Day       time.Time `gorm:"type:date;not null;uniqueIndex:idx_referrer_stat_target_day"`
+	Referrer  string    `gorm:"size:255;not null;uniqueIndex:idx_referrer_stat_target_day"`
+	Views     int       `gorm:"not null;default:0"`
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+func (stat *ReferrerStat) BeforeCreate(tx *gorm.DB) (err error) {
+	if stat.ID == uuid.Nil {
+		stat.ID = uuid.New()
+	}
+	return
+}
+
+// Follow records that a developer follows another developer's activity.
+type Follow struct {
+	ID         uuid.UUID `gorm:"type:uuid;primaryKey;default:uuid_generate_v4()"`
+	Follower   Profile   `gorm:"foreignKey:FollowerID;constraint:OnDelete:CASCADE"`
+	FollowerID uuid.UUID `gorm:"type:uuid;not null;uniqueIndex:idx_follow_follower_followed"`
+	Followed   Profile   `gorm:"foreignKey:FollowedID;constraint:OnDelete:CASCADE"`
+	FollowedID uuid.UUID `gorm:"type:uuid;not null;uniqueIndex:idx_follow_follower_followed;index"`
+	CreatedAt  time.Time
+}
+
+func (follow *Follow) BeforeCreate(tx *gorm.DB) (err error) {
+	if follow.ID == uuid.Nil {
+		follow.ID = uuid.New()
+	}
+	return
+}
+
+// Activity event kinds.
+const (
+	ActivityProjectCreated = "project_created"
+	ActivityProjectUpdated = "project_updated"
+	ActivitySkillAdded     = "skill_added"
+	ActivityReviewReceived = "review_received"
+)
+
+// ActivityEvent is an entry of a developer's activity log, shown in the feeds of their followers.
+// Title, Detail and URL are captured when the event happens, so the log reads the same after later edits.
+type ActivityEvent struct {
+	ID        uuid.UUID `gorm:"type:uuid;primaryKey;default:uuid_generate_v4()"`
+	Kind      string    `gorm:"size:32;not null"`
+	Actor     Profile   `gorm:"foreignKey:ActorID;constraint:OnDelete:CASCADE"`
+	ActorID   uuid.UUID `gorm:"type:uuid;not null;index:idx_activity_actor_created"`
+	SubjectID uuid.UUID `gorm:"type:uuid;not null"` // Project or skill the event is about
+	Title     string    `gorm:"size:255;not null"`
+	Detail    string    `gorm:"size:255"`
+	URL       string    `gorm:"size:255"`
+	CreatedAt time.Time `gorm:"index:idx_activity_actor_created"`
+}
+
+func (event *ActivityEvent) BeforeCreate(tx *gorm.DB) (err error) {
+	if event.ID == uuid.Nil {
+		event.ID = uuid.New()
+	}
+	return
+}
+
+// Summary describes the event in a short sentence, without the actor's name.
+func (event ActivityEvent) Summary() string {
+	switch event.Kind {
+	case ActivityProjectCreated:
+		return "published a new project"
+	case ActivityProjectUpdated:
+		return "updated a project"
+	case ActivitySkillAdded:
+		return "added a skill"
+	case ActivityReviewReceived:
+		return "received a review on"
+	}
+	return event.Kind
+}
+
+// Candidate pipeline stages, in the order candidates usually move through them.
+const (
+	PipelineStageShortlisted  = "shortlisted"
+	PipelineStageContacted    = "contacted"
+	PipelineStageInterviewing = "interviewing"
+	PipelineStageOffer        = "offer"
+	PipelineStageRejected     = "rejected"
+)
+
+// PipelineStages lists the candidate pipeline stages.
+var PipelineStages = []string{PipelineStageShortlisted, PipelineStageContacted, PipelineStageInterviewing, PipelineStageOffer, PipelineStageRejected}
+
+// Shortlist is a named, private list of candidate profiles kept by a recruiter.
+type Shortlist struct {
+	ID         uuid.UUID            `gorm:"type:uuid;primaryKey;default:uuid_generate_v4()"`
+	Owner      Profile              `gorm:"foreignKey:OwnerID;constraint:OnDelete:CASCADE"`
+	OwnerID    uuid.UUID            `gorm:"type:uuid;not null;index"`
+	Name       string               `gorm:"size:255;not null"`
+	Candidates []ShortlistCandidate `gorm:"foreignKey:ShortlistID;constraint:OnDelete:CASCADE"`
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+func (shortlist *Shortlist) BeforeCreate(tx *gorm.DB) (err error) {
+	if shortlist.ID == uuid.Nil {
+		shortlist.ID = uuid.New()
+	}
+	return
+}
+
+// ShortlistCandidate is a profile on a shortlist, with its pipeline stage and the recruiter's private note.
+type ShortlistCandidate struct {
+	ID          uuid.UUID `gorm:"type:uuid;primaryKey;default:uuid_generate_v4()"`
+	ShortlistID uuid.UUID `gorm:"type:uuid;not null;uniqueIndex:idx_shortlist_candidate_profile"`
+	Profile     Profile   `gorm:"foreignKey:ProfileID;constraint:OnDelete:CASCADE"`
+	ProfileID   uuid.UUID `gorm:"type:uuid;not null;uniqueIndex:idx_shortlist_candidate_profile"`
+	Stage       string    `gorm:"size:32;not null;default:'shortlisted'"`
+	Note        string
 	CreatedAt   time.Time
 	UpdatedAt   time.Time
 }
 
-func (skill *Skill) BeforeCreate(tx *gorm.DB) (err error) {
+func (candidate *ShortlistCandidate) BeforeCreate(tx *gorm.DB) (err error) {
+	if candidate.ID == uuid.Nil {
+		candidate.ID = uuid.New()
+	}
+	return
+}
+
+// SavedSearch is a developer search a user kept to rerun later, optionally with alerts about new matches.
+// The criteria mirror the filters of the developer search.
+type SavedSearch struct {
+	ID              uuid.UUID `gorm:"type:uuid;primaryKey;default:uuid_generate_v4()"`
+	Owner           Profile   `gorm:"foreignKey:OwnerID;constraint:OnDelete:CASCADE"`
+	OwnerID         uuid.UUID `gorm:"type:uuid;not null;index"`
+	Name            string    `gorm:"size:255;not null"`
+	Query           string    `gorm:"size:255"`
+	SortBy          string    `gorm:"size:32"`
+	Availability    string    `gorm:"size:32"`
+	WorkArrangement string    `gorm:"size:32"`
+	Role            string    `gorm:"size:255"`
+	TimeZone        string    `gorm:"size:64"`
+	MaxSalary       int       `gorm:"default:0"`
+	SalaryCurrency  string    `gorm:"size:3"`
+	AlertsEnabled   bool      `gorm:"default:false;index"`
+	LastViewedAt    time.Time
+	LastAlertedAt   time.Time
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+}
+
+func (search *SavedSearch) BeforeCreate(tx *gorm.DB) (err error) {
+	if search.ID == uuid.Nil {
+		search.ID = uuid.New()
+	}
+	return
+}
+
+// JobPosting is an open position a member advertises on behalf of a company.
+type JobPosting struct {
+	ID             uuid.UUID `gorm:"type:uuid;primaryKey;default:uuid_generate_v4()"`
+	Owner          Profile   `gorm:"foreignKey:OwnerID;constraint:OnDelete:CASCADE"`
+	OwnerID        uuid.UUID `gorm:"type:uuid;not null;index"`
+	Company        string    `gorm:"size:255;not null"`
+	Title          string    `gorm:"size:255;not null"`
+	Location       string    `gorm:"size:255"`
+	Remote         bool      `gorm:"default:false;index"`
+	Description    string
+	RequiredSkills []JobPostingSkill `gorm:"foreignKey:JobPostingID;constraint:OnDelete:CASCADE"`
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+func (posting *JobPosting) BeforeCreate(tx *gorm.DB) (err error) {
+	if posting.ID == uuid.Nil {
+		posting.ID = uuid.New()
+	}
+	return
+}
+
+// URL returns the path of the job posting page.
+func (posting JobPosting) URL() string {
+	return "/job/" + posting.ID.String()
+}
+
+// SkillNames returns the names of the required skills, comma separated as on the posting form.
+func (posting JobPosting) SkillNames() string {
+	names := make([]string, 0, len(posting.RequiredSkills))
+	for _, skill := range posting.RequiredSkills {
+		names = append(names, skill.Name)
+	}
+	return strings.Join(names, ", ")
+}
+
+// JobPostingSkill is a skill a job posting asks for, linked to its skill catalog entry.
+type JobPostingSkill struct {
+	ID             uuid.UUID     `gorm:"type:uuid;primaryKey;default:uuid_generate_v4()"`
+	JobPostingID   uuid.UUID     `gorm:"type:uuid;not null;uniqueIndex:idx_job_posting_skill"`
+	CatalogSkill   *CatalogSkill `gorm:"foreignKey:CatalogSkillID"`
+	CatalogSkillID uuid.UUID     `gorm:"type:uuid;not null;index;uniqueIndex:idx_job_posting_skill"`
+	Name           string        `gorm:"size:255;not null"`
+}
+
+func (skill *JobPostingSkill) BeforeCreate(tx *gorm.DB) (err error) {
 	if skill.ID == uuid.Nil {
 		skill.ID = uuid.New()
 	}
 	return
 }
 
+// SimilarProfile is one of a profile's precomputed most similar profiles.
+type SimilarProfile struct {
+	ID         uuid.UUID `gorm:"type:uuid;primaryKey;default:uuid_generate_v4()"`
+	ProfileID  uuid.UUID `gorm:"type:uuid;not null;uniqueIndex:idx_similar_profile_pair"`
+	Neighbor   Profile   `gorm:"foreignKey:NeighborID;constraint:OnDelete:CASCADE"`
+	NeighborID uuid.UUID `gorm:"type:uuid;not null;uniqueIndex:idx_similar_profile_pair"`
+	Score      float64   `gorm:"not null"`
+	CreatedAt  time.Time
+}
+
+func (similar *SimilarProfile) BeforeCreate(tx *gorm.DB) (err error) {
+	if similar.ID == uuid.Nil {
+		similar.ID = uuid.New()
+	}
+	return
+}
+
+// RelatedProject is one of a project's precomputed most similar projects.
+type RelatedProject struct {
+	ID         uuid.UUID `gorm:"type:uuid;primaryKey;default:uuid_generate_v4()"`
+	ProjectID  uuid.UUID `gorm:"type:uuid;not null;uniqueIndex:idx_related_project_pair"`
+	Neighbor   Project   `gorm:"foreignKey:NeighborID;constraint:OnDelete:CASCADE"`
+	NeighborID uuid.UUID `gorm:"type:uuid;not null;uniqueIndex:idx_related_project_pair"`
+	Score      float64   `gorm:"not null"`
+	CreatedAt  time.Time
+}
+
+func (related *RelatedProject) BeforeCreate(tx *gorm.DB) (err error) {
+	if related.ID == uuid.Nil {
+		related.ID = uuid.New()
+	}
+	return
+}
+
+type Experience struct {
+	ID           uuid.UUID `gorm:"type:uuid;primaryKey;default:uuid_generate_v4()"`
+	OwnerID      uuid.UUID `gorm:"type:uuid;not null;index"`
+	Company      string    `gorm:"size:255;not null"`
+	Title        string    `gorm:"size:255;not null"`
+	Location     string    `gorm:"size:255"`
+	StartDate    time.Time `gorm:"not null"`
+	EndDate      *time.Time
+	Description  string
+	Technologies string `gorm:"size:512"`
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
+
+func (experience *Experience) BeforeCreate(tx *gorm.DB) (err error) {
+	if experience.ID == uuid.Nil {
+		experience.ID = uuid.New()
+	}
+	return
+}
+
+// TechnologyList returns the comma separated technologies as a list.
+func (experience Experience) TechnologyList() []string {
+	var technologies []string
+	for _, technology := range strings.Split(experience.Technologies, ",") {
+		if technology = strings.TrimSpace(technology); technology != "" {
+			technologies = append(technologies, technology)
+		}
+	}
+	return technologies
+}
+
+type Education struct {
+	ID           uuid.UUID `gorm:"type:uuid;primaryKey;default:uuid_generate_v4()"`
+	OwnerID      uuid.UUID `gorm:"type:uuid;not null;index"`
+	School       string    `gorm:"size:255;not null"`
+	Degree       string    `gorm:"size:255"`
+	FieldOfStudy string    `gorm:"size:255"`
+	StartDate    time.Time `gorm:"not null"`
+	EndDate      *time.Time
+	Description  string
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
+
+func (education *Education) BeforeCreate(tx *gorm.DB) (err error) {
+	if education.ID == uuid.Nil {
+		education.ID = uuid.New()
+	}
+	return
+}
+
 type Message struct {
 	ID          uuid.UUID `gorm:"type:uuid;primaryKey;default:uuid_generate_v4()"`
 	Sender      User      `gorm:"foreignKey:SenderID"`
@@ -102,22 +750,40 @@ func (message *Message) BeforeCreate(tx *gorm.DB) (err error) {
 }
 
 type Project struct {
-	ID            uuid.UUID `gorm:"type:uuid;primaryKey;default:uuid_generate_v4()"`
-	Owner         User      `gorm:"foreignKey:OwnerID"`
-	OwnerID       uuid.UUID `gorm:"type:uuid"`
-	Title         string    `gorm:"size:255;not null"`
-	Description   string    `gorm:"not null"`
-	FeaturedImage string    `gorm:"size:255;default:'default.jpg'"`
-	DemoLink      string    `gorm:"size:255"`
-	SourceLink    string    `gorm:"size:255"`
-	Tags          []Tag     `gorm:"many2many:project_tags;"`
-	Reviews       []Review  `gorm:"foreignKey:ProjectID"`
-	VoteTotal     int       `gorm:"default:0"`
-	VoteRatio     int       `gorm:"default:0"`
+	ID            uuid.UUID             `gorm:"type:uuid;primaryKey;default:uuid_generate_v4()"`
+	Owner         User                  `gorm:"foreignKey:OwnerID"`
+	OwnerID       uuid.UUID             `gorm:"type:uuid"`
+	Title         string                `gorm:"size:255;not null"`
+	Slug          string                `gorm:"size:255;uniqueIndex:idx_projects_live_slug,where:deleted_at IS NULL"` // Unique among projects outside the trash
+	Description   string                `gorm:"not null"`
+	FeaturedImage string                `gorm:"size:255;default:'default.jpg'"`
+	DemoLink      string                `gorm:"size:255"`
+	SourceLink    string                `gorm:"size:255"`
+	Tags          []Tag                 `gorm:"many2many:project_tags;"`
+	Reviews       []Review              `gorm:"foreignKey:ProjectID"`
+	Collaborators []ProjectCollaborator `gorm:"foreignKey:ProjectID;constraint:OnDelete:CASCADE"`
+	SourceStats   *SourceRepoStats      `gorm:"foreignKey:ProjectID;constraint:OnDelete:CASCADE"`
+	VoteTotal     int                   `gorm:"default:0"`
+	VoteRatio     int                   `gorm:"default:0"`
+	Status        string                `gorm:"size:20;not null;default:'published';index"`
+	PublishAt     *time.Time            `gorm:"index"` // When a draft is published automatically, if scheduled
+	HiddenAt      *time.Time            `gorm:"index"` // Set by moderators to take the project out of public listings
+	DeletedAt     gorm.DeletedAt        `gorm:"index"` // Set while the project is in the trash; GORM leaves it out of queries
 	CreatedAt     time.Time
 	UpdatedAt     time.Time
 }
 
+// Project statuses. Only published projects are listed; drafts and archived projects can only
+// be opened by their owner, collaborators and staff.
+const (
+	ProjectStatusDraft     = "draft"
+	ProjectStatusPublished = "published"
+	ProjectStatusArchived  = "archived"
+)
+
+// ProjectStatuses lists the valid project statuses.
+var ProjectStatuses = []string{ProjectStatusDraft, ProjectStatusPublished, ProjectStatusArchived}
+
 func (project *Project) BeforeCreate(tx *gorm.DB) (err error) {
 	if project.ID == uuid.Nil {
 		project.ID = uuid.New()
@@ -125,6 +791,176 @@ func (project *Project) BeforeCreate(tx *gorm.DB) (err error) {
 	return
 }
 
+// IsHidden reports whether a moderator hid the project.
+func (project Project) IsHidden() bool {
+	return project.HiddenAt != nil
+}
+
+// IsPublished reports whether the project is published. Projects saved before statuses existed
+// count as published.
+func (project Project) IsPublished() bool {
+	return project.Status == ProjectStatusPublished || project.Status == ""
+}
+
+// CurrentSourceStats returns what the code host last reported about the source repository,
+// or nil while the current SourceLink has not been fetched yet.
+func (project Project) CurrentSourceStats() *SourceRepoStats {
+	if project.SourceStats == nil || project.SourceLink == "" || project.SourceStats.SourceLink != project.SourceLink {
+		return nil
+	}
+	return project.SourceStats
+}
+
+// URL returns the shareable path of the project, falling back to its ID while it has no slug.
+func (project Project) URL() string {
+	if project.Slug != "" {
+		return "/p/" + url.PathEscape(project.Slug)
+	}
+	return "/project/" + project.ID.String()
+}
+
+// ProjectRevision is a snapshot of a project's editable fields, recorded every time the
+// project is created, updated or reverted. Revisions are numbered from 1 per project, and the
+// author's username is copied so the history stays readable after the account is gone.
+type ProjectRevision struct {
+	ID            uuid.UUID  `gorm:"type:uuid;primaryKey;default:uuid_generate_v4()"`
+	ProjectID     uuid.UUID  `gorm:"type:uuid;not null;uniqueIndex:idx_project_revision_number"`
+	Number        int        `gorm:"not null;uniqueIndex:idx_project_revision_number"`
+	AuthorID      *uuid.UUID `gorm:"type:uuid"` // Nil for the baseline of projects edited before revisions were kept
+	AuthorName    string     `gorm:"size:200"`
+	Title         string     `gorm:"size:255"`
+	Slug          string     `gorm:"size:255"`
+	Description   string
+	FeaturedImage string `gorm:"size:255"`
+	DemoLink      string `gorm:"size:255"`
+	SourceLink    string `gorm:"size:255"`
+	Tags          string // Comma-separated tag names, sorted
+	RevertedFrom  int    // Number of the revision this one restored, 0 for ordinary edits
+	CreatedAt     time.Time
+}
+
+func (revision *ProjectRevision) BeforeCreate(tx *gorm.DB) (err error) {
+	if revision.ID == uuid.Nil {
+		revision.ID = uuid.New()
+	}
+	return
+}
+
+// TagNames returns the tag names of the revision.
+func (revision ProjectRevision) TagNames() []string {
+	if revision.Tags == "" {
+		return nil
+	}
+	return strings.Split(revision.Tags, ",")
+}
+
+// ProjectRevisionChange is the value of one field of a project before and after a revision.
+type ProjectRevisionChange struct {
+	Field  string
+	Before string
+	After  string
+}
+
+// SourceRepoStats is what the code host of a project's SourceLink last reported about the
+// repository. It is refreshed by a background job; the link it was fetched for is kept so a
+// changed SourceLink is fetched again instead of showing the old repository's numbers.
+type SourceRepoStats struct {
+	ProjectID    uuid.UUID `gorm:"type:uuid;primaryKey"`
+	SourceLink   string    `gorm:"size:255"`
+	Host         string    `gorm:"size:100"`
+	Stars        int
+	LastCommitAt *time.Time // Nil for repositories without commits
+	License      string     `gorm:"size:100"`
+	Archived     bool
+	NotFound     bool       // The host reported that the repository does not exist or is private
+	LastError    string     `gorm:"size:255"` // Why the last check failed; the other fields keep the values fetched before
+	CheckedAt    time.Time  `gorm:"index"`
+	SyncedAt     *time.Time // When the host last answered, nil while it never has
+}
+
+// Synced reports whether the code host has answered for the repository at least once.
+func (stats SourceRepoStats) Synced() bool {
+	return stats.SyncedAt != nil
+}
+
+// Kinds of pages whose links are checked.
+const (
+	LinkKindProfile = "profile"
+	LinkKindProject = "project"
+)
+
+// Checked link fields, named after their form fields.
+const (
+	LinkFieldDemo     = "demo_link"
+	LinkFieldSource   = "source_link"
+	LinkFieldGithub   = "social_github"
+	LinkFieldLinkedin = "social_linkedin"
+	LinkFieldWebsite  = "social_website"
+)
+
+// LinkFieldLabels names the checked link fields for people.
+var LinkFieldLabels = map[string]string{
+	LinkFieldDemo:     "Demo link",
+	LinkFieldSource:   "Source link",
+	LinkFieldGithub:   "GitHub link",
+	LinkFieldLinkedin: "LinkedIn link",
+	LinkFieldWebsite:  "Website link",
+}
+
+// Outcomes of a link check. A blocked link answered, but refused automated requests, so it
+// counts neither as working nor as failing.
+const (
+	LinkStateOK      = "ok"
+	LinkStateFailed  = "failed"
+	LinkStateBlocked = "blocked"
+)
+
+// LinkStatus is the latest health of one link of a profile or project. A link is flagged broken
+// once several checks in a row failed, and its owner is told once per breakage. A status whose
+// URL differs from the current link belongs to the link the field had before.
+type LinkStatus struct {
+	Kind                string     `gorm:"size:32;primaryKey"`
+	TargetID            uuid.UUID  `gorm:"type:uuid;primaryKey"`
+	Field               string     `gorm:"size:32;primaryKey"`
+	OwnerID             uuid.UUID  `gorm:"type:uuid;not null;index"` // User owning the profile or project
+	URL                 string     `gorm:"size:255;not null"`
+	State               string     `gorm:"size:20;not null"`
+	StatusCode          int        // 0 when no response was received
+	LastError           string     `gorm:"size:255"`
+	ConsecutiveFailures int        `gorm:"not null;default:0"`
+	BrokenSince         *time.Time // Set when the link was flagged broken
+	NotifiedAt          *time.Time // When the owner was told about the current breakage
+	CheckedAt           time.Time  `gorm:"index"`
+}
+
+// IsBroken reports whether the link is flagged broken.
+func (status LinkStatus) IsBroken() bool {
+	return status.BrokenSince != nil
+}
+
+// LinkCheck is one check of a link, kept as its status history.
+type LinkCheck struct {
+	ID         uuid.UUID `gorm:"type:uuid;primaryKey;default:uuid_generate_v4()"`
+	Kind       string    `gorm:"size:32;not null;index:idx_link_check_target"`
+	TargetID   uuid.UUID `gorm:"type:uuid;not null;index:idx_link_check_target"`
+	Field      string    `gorm:"size:32;not null;index:idx_link_check_target"`
+	OwnerID    uuid.UUID `gorm:"type:uuid;not null;index"`
+	URL        string    `gorm:"size:255;not null"`
+	State      string    `gorm:"size:20;not null"`
+	StatusCode int
+	Error      string `gorm:"size:255"`
+	Attempts   int
+	DurationMs int
+	CheckedAt  time.Time `gorm:"index"`
+}
+
+func (check *LinkCheck) BeforeCreate(tx *gorm.DB) (err error) {
+	if check.ID == uuid.Nil {
+		check.ID = uuid.New()
+	}
+	return
+}
+
 type Tag struct {
 	ID        uuid.UUID `gorm:"type:uuid;primaryKey;default:uuid_generate_v4()"`
 	Name      string    `gorm:"size:255;not null"`
@@ -140,14 +976,21 @@ func (tag *Tag) BeforeCreate(tx *gorm.DB) (err error) {
 	return
 }
 
+// Review vote values.
+const (
+	VoteUp   = "up"
+	VoteDown = "down"
+)
+
 type Review struct {
-	ID        uuid.UUID `gorm:"type:uuid;primaryKey;default:uuid_generate_v4()"`
-	Project   Project   `gorm:"foreignKey:ProjectID"`
-	ProjectID uuid.UUID `gorm:"type:uuid;not null"`
-	Owner     User      `gorm:"foreignKey:OwnerID"`
-	OwnerID   uuid.UUID `gorm:"type:uuid;not null"`
-	Body      string    `gorm:"not null"`
-	Value     string    `gorm:"size:255;not null"`
+	ID        uuid.UUID  `gorm:"type:uuid;primaryKey;default:uuid_generate_v4()"`
+	Project   Project    `gorm:"foreignKey:ProjectID"`
+	ProjectID uuid.UUID  `gorm:"type:uuid;not null"`
+	Owner     User       `gorm:"foreignKey:OwnerID"`
+	OwnerID   uuid.UUID  `gorm:"type:uuid;not null"`
+	Body      string     `gorm:"not null"`
+	Value     string     `gorm:"size:255;not null"`
+	HiddenAt  *time.Time // Set by moderation; hidden reviews are not shown or counted
 	CreatedAt time.Time
 	UpdatedAt time.Time
 }
@@ -158,3 +1001,219 @@ func (review *Review) BeforeCreate(tx *gorm.DB) (err error) {
 	}
 	return
 }
+
+// IsHidden reports whether moderation hid the review.
+func (review Review) IsHidden() bool {
+	return review.HiddenAt != nil
+}
+
+// Project collaborator roles. Maintainers may edit the project and invite contributors;
+// contributors are credited on the project page.
+const (
+	CollaboratorRoleMaintainer  = "maintainer"
+	CollaboratorRoleContributor = "contributor"
+)
+
+// CollaboratorRoles lists the roles a project collaborator can have.
+var CollaboratorRoles = []string{CollaboratorRoleMaintainer, CollaboratorRoleContributor}
+
+// ProjectCollaborator is a developer invited to work on someone else's project. The
+// invitation grants nothing until the invitee accepts it.
+type ProjectCollaborator struct {
+	ID          uuid.UUID `gorm:"type:uuid;primaryKey;default:uuid_generate_v4()"`
+	Project     Project   `gorm:"foreignKey:ProjectID"`
+	ProjectID   uuid.UUID `gorm:"type:uuid;not null;uniqueIndex:idx_project_collaborator"`
+	Profile     Profile   `gorm:"foreignKey:ProfileID;constraint:OnDelete:CASCADE"`
+	ProfileID   uuid.UUID `gorm:"type:uuid;not null;uniqueIndex:idx_project_collaborator;index"`
+	Role        string    `gorm:"size:32;not null"`
+	InvitedByID uuid.UUID `gorm:"type:uuid;not null"`
+	AcceptedAt  *time.Time
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+func (collaborator *ProjectCollaborator) BeforeCreate(tx *gorm.DB) (err error) {
+	if collaborator.ID == uuid.Nil {
+		collaborator.ID = uuid.New()
+	}
+	return
+}
+
+// IsAccepted reports whether the invitee accepted the invitation.
+func (collaborator ProjectCollaborator) IsAccepted() bool {
+	return collaborator.AcceptedAt != nil
+}
+
+// Kinds of content that can be reported to moderators.
+const (
+	ReportTargetProject = "project"
+	ReportTargetProfile = "profile"
+	ReportTargetReview  = "review"
+	ReportTargetMessage = "message"
+)
+
+// ReportTargets lists the kinds of content that can be reported.
+var ReportTargets = []string{ReportTargetProject, ReportTargetProfile, ReportTargetReview, ReportTargetMessage}
+
+// Reasons a report can give.
+const (
+	ReportReasonSpam          = "spam"
+	ReportReasonFake          = "fake"
+	ReportReasonAbuse         = "abuse"
+	ReportReasonInappropriate = "inappropriate"
+	ReportReasonOther         = "other"
+)
+
+// ReportReasons lists the reasons a report can give.
+var ReportReasons = []string{ReportReasonSpam, ReportReasonFake, ReportReasonAbuse, ReportReasonInappropriate, ReportReasonOther}
+
+// States of a moderation case. Open cases are in the moderation queue; moderators resolve
+// them by taking action against the content or by dismissing the reports.
+const (
+	ReportCaseOpen      = "open"
+	ReportCaseActioned  = "actioned"
+	ReportCaseDismissed = "dismissed"
+)
+
+// ReportCaseStatuses lists the states of a moderation case.
+var ReportCaseStatuses = []string{ReportCaseOpen, ReportCaseActioned, ReportCaseDismissed}
+
+// ReportCase collects all reports about one piece of content, so that the moderation queue has
+// a single entry per target. The target's title, link and an excerpt are copied when the case
+// is opened so moderators can judge it even when it is private or deleted.
+type ReportCase struct {
+	ID            uuid.UUID  `gorm:"type:uuid;primaryKey;default:uuid_generate_v4()"`
+	TargetKind    string     `gorm:"size:20;not null;uniqueIndex:idx_report_case_target"`
+	TargetID      uuid.UUID  `gorm:"type:uuid;not null;uniqueIndex:idx_report_case_target"`
+	TargetTitle   string     `gorm:"size:255"`
+	TargetURL     string     `gorm:"size:255"`
+	TargetExcerpt string     `gorm:"type:text"`
+	Status        string     `gorm:"size:20;not null;default:'open';index"`
+	ReportCount   int        `gorm:"default:0"` // Reports since the case was last opened
+	AutoHiddenAt  *time.Time // Set when the reports reached the threshold and the target was hidden automatically
+	ResolvedAt    *time.Time
+	ResolvedBy    *User      `gorm:"foreignKey:ResolvedByID"`
+	ResolvedByID  *uuid.UUID `gorm:"type:uuid"`
+	Reports       []Report   `gorm:"foreignKey:CaseID;constraint:OnDelete:CASCADE"`
+	LastReportAt  time.Time  `gorm:"index"`
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
+
+func (reportCase *ReportCase) BeforeCreate(tx *gorm.DB) (err error) {
+	if reportCase.ID == uuid.Nil {
+		reportCase.ID = uuid.New()
+	}
+	return
+}
+
+// Report is one user's report about a piece of content. Each user reports a target at most once.
+type Report struct {
+	ID         uuid.UUID `gorm:"type:uuid;primaryKey;default:uuid_generate_v4()"`
+	CaseID     uuid.UUID `gorm:"type:uuid;not null;uniqueIndex:idx_report_reporter"`
+	Reporter   User      `gorm:"foreignKey:ReporterID;constraint:OnDelete:CASCADE"`
+	ReporterID uuid.UUID `gorm:"type:uuid;not null;uniqueIndex:idx_report_reporter"`
+	Reason     string    `gorm:"size:20;not null"`
+	Details    string    `gorm:"type:text"`
+	CreatedAt  time.Time
+}
+
+func (report *Report) BeforeCreate(tx *gorm.DB) (err error) {
+	if report.ID == uuid.Nil {
+		report.ID = uuid.New()
+	}
+	return
+}
+
+// Actions recorded in the audit log.
+const (
+	AuditActionRegister       = "account.register"
+	AuditActionLogin          = "account.login"
+	AuditActionLoginFailed    = "account.login_failed"
+	AuditActionLogout         = "account.logout"
+	AuditActionAccountUpdate  = "account.update"
+	AuditActionAccountDelete  = "account.delete"
+	AuditActionAccountRestore = "account.restore"
+	AuditActionProjectCreate  = "project.create"
+	AuditActionProjectUpdate  = "project.update"
+	AuditActionProjectDelete  = "project.delete"
+	AuditActionProjectRestore = "project.restore"
+	AuditActionProjectRevert  = "project.revert"
+	AuditActionProjectPublish = "project.publish"
+	AuditActionProjectHide    = "project.hide"
+	AuditActionProjectUnhide  = "project.unhide"
+	AuditActionUserSuspend    = "user.suspend"
+	AuditActionUserReinstate  = "user.reinstate"
+	AuditActionUserRole       = "user.role"
+	AuditActionReviewDelete   = "review.delete"
+	AuditActionReportResolve  = "report.resolve"
+)
+
+// AuditActions lists the actions recorded in the audit log.
+var AuditActions = []string{
+	AuditActionRegister, AuditActionLogin, AuditActionLoginFailed, AuditActionLogout, AuditActionAccountUpdate,
+	AuditActionAccountDelete, AuditActionAccountRestore, AuditActionProjectCreate, AuditActionProjectUpdate,
+	AuditActionProjectDelete, AuditActionProjectRestore, AuditActionProjectRevert, AuditActionProjectPublish,
+	AuditActionProjectHide, AuditActionProjectUnhide, AuditActionUserSuspend, AuditActionUserReinstate,
+	AuditActionUserRole, AuditActionReviewDelete, AuditActionReportResolve,
+}
+
+// Kinds of resources audit events are about.
+const (
+	AuditTargetUser       = "user"
+	AuditTargetProject    = "project"
+	AuditTargetReview     = "review"
+	AuditTargetReportCase = "report_case"
+)
+
+// ErrAuditEventImmutable is returned when something tries to change or delete an audit event.
+var ErrAuditEventImmutable = errors.New("audit events cannot be changed or deleted")
+
+// AuditEvent records who did what to which resource, from where. The log is append-only: events
+// are never updated or deleted. The actor's username and the target's label are copied so
+// events stay readable after the user or the resource is renamed or deleted.
+type AuditEvent struct {
+	ID          uuid.UUID  `gorm:"type:uuid;primaryKey;default:uuid_generate_v4()"`
+	ActorID     *uuid.UUID `gorm:"type:uuid;index"` // Nil for anonymous visitors, the command line and background jobs
+	ActorName   string     `gorm:"size:200"`
+	Action      string     `gorm:"size:40;not null;index"`
+	TargetKind  string     `gorm:"size:20;index:idx_audit_target"`
+	TargetID    *uuid.UUID `gorm:"type:uuid;index:idx_audit_target"`
+	TargetLabel string     `gorm:"size:255"`
+	IP          string     `gorm:"size:45"`
+	UserAgent   string     `gorm:"size:255"`
+	Changes     string     `gorm:"type:text"` // JSON array of AuditChange
+	CreatedAt   time.Time  `gorm:"index"`
+}
+
+func (event *AuditEvent) BeforeCreate(tx *gorm.DB) (err error) {
+	if event.ID == uuid.Nil {
+		event.ID = uuid.New()
+	}
+	return
+}
+
+func (event *AuditEvent) BeforeUpdate(tx *gorm.DB) (err error) {
+	return ErrAuditEventImmutable
+}
+
+func (event *AuditEvent) BeforeDelete(tx *gorm.DB) (err error) {
+	return ErrAuditEventImmutable
+}
+
+// AuditChange is the value of one field before and after an audited action. Before is empty
+// for created resources and After for deleted ones.
+type AuditChange struct {
+	Field  string `json:"field"`
+	Before string `json:"before"`
+	After  string `json:"after"`
+}
+
+// ChangeList decodes the changes recorded with the event.
+func (event AuditEvent) ChangeList() []AuditChange {
+	var changes []AuditChange
+	if event.Changes != "" {
+		_ = json.Unmarshal([]byte(event.Changes), &changes)
+	}
+	return changes
+}
@@ -0,0 +1,70 @@
+package infrastructure
+
+import (
+	"devsearch-go/internal/application"
+	"devsearch-go/internal/domain"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// GormActivityRepository implements the application.ActivityRepository interface using GORM.
+type GormActivityRepository struct {
+	DB *gorm.DB
+}
+
+// CreateActivityEvent appends an event to the activity log.
+func (r *GormActivityRepository) CreateActivityEvent(event *domain.ActivityEvent) error {
+	return r.DB.Create(event).Error
+}
+
+// CreateFollow creates a new follow.
+func (r *GormActivityRepository) CreateFollow(follow *domain.Follow) error {
+	return r.DB.Create(follow).Error
+}
+
+// FindFollow retrieves the follow of a profile by a given follower.
+func (r *GormActivityRepository) FindFollow(followerID, followedID uuid.UUID) (*domain.Follow, error) {
+	var follow domain.Follow
+	if err := r.DB.Where("follower_id = ? AND followed_id = ?", followerID, followedID).First(&follow).Error; err != nil {
+		return nil, err
+	}
+	return &follow, nil
+}
+
+// DeleteFollow deletes a follow by its ID.
+func (r *GormActivityRepository) DeleteFollow(id uuid.UUID) error {
+	return r.DB.Delete(&domain.Follow{}, "id = ?", id).Error
+}
+
+// CountFollowers counts the followers of a profile.
+func (r *GormActivityRepository) CountFollowers(profileID uuid.UUID) (int64, error) {
+	var count int64
+	err := r.DB.Model(&domain.Follow{}).Where("followed_id = ?", profileID).Count(&count).Error
+	return count, err
+}
+
+// projectActivityKinds lists the kinds of activity events whose subject is a project.
+var projectActivityKinds = []string{domain.ActivityProjectCreated, domain.ActivityProjectUpdated, domain.ActivityReviewReceived}
+
+// FindFeedEvents retrieves events of followed profiles that are neither private nor hidden,
+// newest first. Like project listings, only events about published projects that are neither
+// hidden by moderators nor in the trash are included; drafts, archived and purged projects are left out.
+func (r *GormActivityRepository) FindFeedEvents(followerID uuid.UUID, after *application.FeedCursor, limit int) ([]domain.ActivityEvent, error) {
+	var events []domain.ActivityEvent
+	query := r.DB.Preload("Actor").
+		Joins("JOIN follows ON follows.followed_id = activity_events.actor_id AND follows.follower_id = ?", followerID).
+		Joins("JOIN profiles ON profiles.id = activity_events.actor_id AND profiles.privacy_mode <> ? AND profiles.hidden_at IS NULL AND profiles.deleted_at IS NULL", domain.ProfileModePrivate).
+		Joins("LEFT JOIN projects ON projects.id = activity_events.subject_id").
+		Where("activity_events.kind NOT IN ? OR (projects.status = ? AND projects.hidden_at IS NULL AND projects.deleted_at IS NULL)",
+			projectActivityKinds, domain.ProjectStatusPublished)
+	if after != nil {
+		query = query.Where("(activity_events.created_at, activity_events.id) < (?, ?)", after.CreatedAt, after.ID)
+	}
+
+	err := query.Order("activity_events.created_at DESC, activity_events.id DESC").Limit(limit).Find(&events).Error
+	if err != nil {
+		return nil, err
+	}
+	return events, nil
+}
@@ -0,0 +1,116 @@
+package infrastructure
+
+import (
+	"devsearch-go/internal/domain"
+
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// GormAdminRepository implements the application.AdminRepository interface using GORM.
+type GormAdminRepository struct {
+	DB *gorm.DB
+}
+
+// FindUsersForAdmin retrieves users matching the search on their username, name or email, newest first, with pagination.
+func (r *GormAdminRepository) FindUsersForAdmin(searchQuery string, page, limit int) ([]domain.User, int64, error) {
+	var users []domain.User
+	query := r.DB.Preload("Profile")
+
+	if searchQuery != "" {
+		like := "%" + searchQuery + "%"
+		query = query.Where("username ILIKE ? OR name ILIKE ? OR email ILIKE ?", like, like, like)
+	}
+
+	var totalUsers int64
+	query.Model(&domain.User{}).Count(&totalUsers)
+
+	offset := (page - 1) * limit
+	err := query.Order("created_at DESC").Limit(limit).Offset(offset).Find(&users).Error
+	if err != nil {
+		return nil, 0, err
+	}
+	return users, totalUsers, nil
+}
+
+// FindProjectsForAdmin retrieves projects matching the search on their title, hidden ones included, newest first, with pagination.
+func (r *GormAdminRepository) FindProjectsForAdmin(searchQuery string, page, limit int) ([]domain.Project, int64, error) {
+	var projects []domain.Project
+	query := r.DB.Preload("Owner")
+
+	if searchQuery != "" {
+		query = query.Where("title ILIKE ?", "%"+searchQuery+"%")
+	}
+
+	var totalProjects int64
+	query.Model(&domain.Project{}).Count(&totalProjects)
+
+	offset := (page - 1) * limit
+	err := query.Order("created_at DESC").Limit(limit).Offset(offset).Find(&projects).Error
+	if err != nil {
+		return nil, 0, err
+	}
+	return projects, totalProjects, nil
+}
+
+// FindReviewsForAdmin retrieves reviews matching the search on their body or project title, newest first, with pagination.
+func (r *GormAdminRepository) FindReviewsForAdmin(searchQuery string, page, limit int) ([]domain.Review, int64, error) {
+	var reviews []domain.Review
+	query := r.DB.Preload("Owner").Preload("Project")
+
+	if searchQuery != "" {
+		like := "%" + searchQuery + "%"
+		query = query.Where("body ILIKE ? OR project_id IN (SELECT id FROM projects WHERE title ILIKE ?)", like, like)
+	}
+
+	var totalReviews int64
+	query.Model(&domain.Review{}).Count(&totalReviews)
+
+	offset := (page - 1) * limit
+	err := query.Order("created_at DESC").Limit(limit).Offset(offset).Find(&reviews).Error
+	if err != nil {
+		return nil, 0, err
+	}
+	return reviews, totalReviews, nil
+}
+
+// FindReviewByID retrieves a single review by its ID, with its author and project.
+func (r *GormAdminRepository) FindReviewByID(id uuid.UUID) (*domain.Review, error) {
+	var review domain.Review
+	if err := r.DB.Preload("Owner").Preload("Project.Collaborators.Profile").First(&review, "id = ?", id).Error; err != nil {
+		return nil, err
+	}
+	return &review, nil
+}
+
+// UpdateUserRole changes the role of a user.
+func (r *GormAdminRepository) UpdateUserRole(userID uuid.UUID, role string) error {
+	return r.DB.Model(&domain.User{}).Where("id = ?", userID).Update("role", role).Error
+}
+
+// SetUserSuspended suspends a user from suspendedAt on, or reinstates them when it is nil.
+func (r *GormAdminRepository) SetUserSuspended(userID uuid.UUID, suspendedAt *time.Time) error {
+	return r.DB.Model(&domain.User{}).Where("id = ?", userID).Update("suspended_at", suspendedAt).Error
+}
+
+// SetProjectHidden hides a project from hiddenAt on, or shows it again when it is nil.
+func (r *GormAdminRepository) SetProjectHidden(projectID uuid.UUID, hiddenAt *time.Time) error {
+	return r.DB.Model(&domain.Project{}).Where("id = ?", projectID).Update("hidden_at", hiddenAt).Error
+}
+
+// SetProfileHidden hides a profile from hiddenAt on, or shows it again when it is nil.
+func (r *GormAdminRepository) SetProfileHidden(profileID uuid.UUID, hiddenAt *time.Time) error {
+	return r.DB.Model(&domain.Profile{}).Where("id = ?", profileID).Update("hidden_at", hiddenAt).Error
+}
+
+// SetReviewHidden hides a review from hiddenAt on, or shows it again when it is nil.
+func (r *GormAdminRepository) SetReviewHidden(reviewID uuid.UUID, hiddenAt *time.Time) error {
+	return r.DB.Model(&domain.Review{}).Where("id = ?", reviewID).Update("hidden_at", hiddenAt).Error
+}
+
+// DeleteReview deletes a review by its ID.
+func (r *GormAdminRepository) DeleteReview(id uuid.UUID) error {
+	return r.DB.Delete(&domain.Review{}, "id = ?", id).Error
+}
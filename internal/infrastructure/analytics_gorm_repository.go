@@ -0,0 +1,76 @@
+package infrastructure
+
+import (
+	"devsearch-go/internal/application"
+	"devsearch-go/internal/domain"
+
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// GormAnalyticsRepository implements the application.AnalyticsRepository interface using GORM.
+type GormAnalyticsRepository struct {
+	DB *gorm.DB
+}
+
+// RecordPageView stores a raw page view unless the visitor already viewed the page that day.
+func (r *GormAnalyticsRepository) RecordPageView(view *domain.PageView) error {
+	return r.DB.Clauses(clause.OnConflict{DoNothing: true}).Create(view).Error
+}
+
+// RollupPageViews recomputes the view and referrer stats of every day still present in page_views.
+func (r *GormAnalyticsRepository) RollupPageViews() error {
+	return r.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Exec(`
+			INSERT INTO view_stats (id, kind, target_id, day, views, created_at, updated_at)
+			SELECT uuid_generate_v4(), kind, target_id, day, COUNT(*), NOW(), NOW()
+			FROM page_views
+			GROUP BY kind, target_id, day
+			ON CONFLICT (kind, target_id, day)
+			DO UPDATE SET views = EXCLUDED.views, updated_at = EXCLUDED.updated_at`).Error; err != nil {
+			return err
+		}
+		return tx.Exec(`
+			INSERT INTO referrer_stats (id, kind, target_id, day, referrer, views, created_at, updated_at)
+			SELECT uuid_generate_v4(), kind, target_id, day, referrer, COUNT(*), NOW(), NOW()
+			FROM page_views
+			GROUP BY kind, target_id, day, referrer
+			ON CONFLICT (kind, target_id, day, referrer)
+			DO UPDATE SET views = EXCLUDED.views, updated_at = EXCLUDED.updated_at`).Error
+	})
+}
+
+// PurgePageViews deletes raw page views of days before the given day.
+func (r *GormAnalyticsRepository) PurgePageViews(before time.Time) error {
+	return r.DB.Where("day < ?", before).Delete(&domain.PageView{}).Error
+}
+
+// FindViewStats retrieves the daily view stats of the given pages since a day, oldest first.
+func (r *GormAnalyticsRepository) FindViewStats(kind string, targetIDs []uuid.UUID, since time.Time) ([]domain.ViewStat, error) {
+	var stats []domain.ViewStat
+	err := r.DB.Where("kind = ? AND target_id IN ? AND day >= ?", kind, targetIDs, since).
+		Order("day ASC").
+		Find(&stats).Error
+	if err != nil {
+		return nil, err
+	}
+	return stats, nil
+}
+
+// FindReferrerCounts retrieves the visitors each referring site sent to the given pages since a day, busiest first.
+func (r *GormAnalyticsRepository) FindReferrerCounts(kind string, targetIDs []uuid.UUID, since time.Time) ([]application.ReferrerCount, error) {
+	var counts []application.ReferrerCount
+	err := r.DB.Model(&domain.ReferrerStat{}).
+		Select("target_id, referrer, SUM(views) AS views").
+		Where("kind = ? AND target_id IN ? AND day >= ?", kind, targetIDs, since).
+		Group("target_id, referrer").
+		Order("views DESC, referrer ASC").
+		Scan(&counts).Error
+	if err != nil {
+		return nil, err
+	}
+	return counts, nil
+}
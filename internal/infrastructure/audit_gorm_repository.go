@@ -0,0 +1,62 @@
+package infrastructure
+
+import (
+	"devsearch-go/internal/application"
+	"devsearch-go/internal/domain"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// GormAuditRepository implements the application.AuditRepository interface using GORM.
+type GormAuditRepository struct {
+	DB *gorm.DB
+}
+
+// CreateAuditEvent appends an event to the audit log, copying the actor's current username
+// when the event has none.
+func (r *GormAuditRepository) CreateAuditEvent(event *domain.AuditEvent) error {
+	if event.ActorID != nil && event.ActorName == "" {
+		var user domain.User
+		if err := r.DB.Select("username").First(&user, "id = ?", *event.ActorID).Error; err == nil {
+			event.ActorName = user.Username
+		}
+	}
+	return r.DB.Create(event).Error
+}
+
+// FindAuditEvents retrieves the audit events matching the filter, newest first, with pagination.
+func (r *GormAuditRepository) FindAuditEvents(filter application.AuditFilter, page, limit int) ([]domain.AuditEvent, int64, error) {
+	var events []domain.AuditEvent
+	query := r.DB.Model(&domain.AuditEvent{})
+
+	if filter.Actor != "" {
+		query = query.Where("LOWER(actor_name) = LOWER(?) OR actor_id IN (SELECT id FROM users WHERE LOWER(username) = LOWER(?))", filter.Actor, filter.Actor)
+	}
+	if filter.Action != "" {
+		query = query.Where("action = ?", filter.Action)
+	}
+	if filter.Target != "" {
+		if targetID, err := uuid.Parse(filter.Target); err == nil {
+			query = query.Where("target_id = ?", targetID)
+		} else {
+			query = query.Where("target_label ILIKE ?", "%"+filter.Target+"%")
+		}
+	}
+	if !filter.From.IsZero() {
+		query = query.Where("created_at >= ?", filter.From)
+	}
+	if !filter.To.IsZero() {
+		query = query.Where("created_at < ?", filter.To.AddDate(0, 0, 1))
+	}
+
+	var totalEvents int64
+	query.Count(&totalEvents)
+
+	offset := (page - 1) * limit
+	err := query.Order("created_at DESC").Limit(limit).Offset(offset).Find(&events).Error
+	if err != nil {
+		return nil, 0, err
+	}
+	return events, totalEvents, nil
+}
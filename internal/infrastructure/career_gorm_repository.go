@@ -0,0 +1,66 @@
+package infrastructure
+
+import (
+	"devsearch-go/internal/domain"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// GormExperienceRepository implements the application.ExperienceRepository interface using GORM.
+type GormExperienceRepository struct {
+	DB *gorm.DB
+}
+
+// CreateExperience creates a new work experience entry.
+func (r *GormExperienceRepository) CreateExperience(experience *domain.Experience) error {
+	return r.DB.Create(experience).Error
+}
+
+// FindExperienceByID retrieves a work experience entry by its ID.
+func (r *GormExperienceRepository) FindExperienceByID(id uuid.UUID) (*domain.Experience, error) {
+	var experience domain.Experience
+	if err := r.DB.First(&experience, "id = ?", id).Error; err != nil {
+		return nil, err
+	}
+	return &experience, nil
+}
+
+// UpdateExperience updates an existing work experience entry.
+func (r *GormExperienceRepository) UpdateExperience(experience *domain.Experience) error {
+	return r.DB.Save(experience).Error
+}
+
+// DeleteExperience deletes a work experience entry by its ID.
+func (r *GormExperienceRepository) DeleteExperience(id uuid.UUID) error {
+	return r.DB.Delete(&domain.Experience{}, "id = ?", id).Error
+}
+
+// GormEducationRepository implements the application.EducationRepository interface using GORM.
+type GormEducationRepository struct {
+	DB *gorm.DB
+}
+
+// CreateEducation creates a new education entry.
+func (r *GormEducationRepository) CreateEducation(education *domain.Education) error {
+	return r.DB.Create(education).Error
+}
+
+// FindEducationByID retrieves an education entry by its ID.
+func (r *GormEducationRepository) FindEducationByID(id uuid.UUID) (*domain.Education, error) {
+	var education domain.Education
+	if err := r.DB.First(&education, "id = ?", id).Error; err != nil {
+		return nil, err
+	}
+	return &education, nil
+}
+
+// UpdateEducation updates an existing education entry.
+func (r *GormEducationRepository) UpdateEducation(education *domain.Education) error {
+	return r.DB.Save(education).Error
+}
+
+// DeleteEducation deletes an education entry by its ID.
+func (r *GormEducationRepository) DeleteEducation(id uuid.UUID) error {
+	return r.DB.Delete(&domain.Education{}, "id = ?", id).Error
+}
@@ -0,0 +1,58 @@
+package infrastructure
+
+import (
+	"devsearch-go/internal/domain"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// GormCollaboratorRepository implements the application.CollaboratorRepository interface using GORM.
+type GormCollaboratorRepository struct {
+	DB *gorm.DB
+}
+
+// FindCollaboratorByID retrieves a collaborator or invitation with its profile, and its project
+// with the project's collaborators for authorisation.
+func (r *GormCollaboratorRepository) FindCollaboratorByID(id uuid.UUID) (*domain.ProjectCollaborator, error) {
+	var collaborator domain.ProjectCollaborator
+	if err := r.DB.Preload("Project.Collaborators.Profile").Preload("Profile").First(&collaborator, "id = ?", id).Error; err != nil {
+		return nil, err
+	}
+	return &collaborator, nil
+}
+
+// FindCollaborator retrieves the collaborator or invitation of a profile on a project.
+func (r *GormCollaboratorRepository) FindCollaborator(projectID, profileID uuid.UUID) (*domain.ProjectCollaborator, error) {
+	var collaborator domain.ProjectCollaborator
+	if err := r.DB.First(&collaborator, "project_id = ? AND profile_id = ?", projectID, profileID).Error; err != nil {
+		return nil, err
+	}
+	return &collaborator, nil
+}
+
+// FindPendingInvitations retrieves the unanswered invitations of a profile to projects that are not in the trash, newest first.
+func (r *GormCollaboratorRepository) FindPendingInvitations(profileID uuid.UUID) ([]domain.ProjectCollaborator, error) {
+	var invitations []domain.ProjectCollaborator
+	err := r.DB.Preload("Project").Where("profile_id = ? AND accepted_at IS NULL AND project_id IN (SELECT id FROM projects WHERE deleted_at IS NULL)", profileID).
+		Order("created_at DESC").Find(&invitations).Error
+	if err != nil {
+		return nil, err
+	}
+	return invitations, nil
+}
+
+// CreateCollaborator creates a new invitation.
+func (r *GormCollaboratorRepository) CreateCollaborator(collaborator *domain.ProjectCollaborator) error {
+	return r.DB.Omit("Project", "Profile").Create(collaborator).Error
+}
+
+// UpdateCollaborator saves a collaborator's role and acceptance.
+func (r *GormCollaboratorRepository) UpdateCollaborator(collaborator *domain.ProjectCollaborator) error {
+	return r.DB.Model(collaborator).Select("Role", "AcceptedAt").Updates(collaborator).Error
+}
+
+// DeleteCollaborator deletes a collaborator or invitation.
+func (r *GormCollaboratorRepository) DeleteCollaborator(id uuid.UUID) error {
+	return r.DB.Delete(&domain.ProjectCollaborator{}, "id = ?", id).Error
+}
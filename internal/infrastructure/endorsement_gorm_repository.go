@@ -0,0 +1,32 @@
+package infrastructure
+
+import (
+	"devsearch-go/internal/domain"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// GormEndorsementRepository implements the application.EndorsementRepository interface using GORM.
+type GormEndorsementRepository struct {
+	DB *gorm.DB
+}
+
+// CreateEndorsement creates a new skill endorsement.
+func (r *GormEndorsementRepository) CreateEndorsement(endorsement *domain.Endorsement) error {
+	return r.DB.Create(endorsement).Error
+}
+
+// FindEndorsement retrieves the endorsement of a skill by a given profile.
+func (r *GormEndorsementRepository) FindEndorsement(skillID, endorserID uuid.UUID) (*domain.Endorsement, error) {
+	var endorsement domain.Endorsement
+	if err := r.DB.Where("skill_id = ? AND endorser_id = ?", skillID, endorserID).First(&endorsement).Error; err != nil {
+		return nil, err
+	}
+	return &endorsement, nil
+}
+
+// DeleteEndorsement deletes an endorsement by its ID.
+func (r *GormEndorsementRepository) DeleteEndorsement(id uuid.UUID) error {
+	return r.DB.Delete(&domain.Endorsement{}, "id = ?", id).Error
+}
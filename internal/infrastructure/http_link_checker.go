@@ -0,0 +1,235 @@
+package infrastructure
+
+import (
+	"devsearch-go/internal/application"
+
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+)
+
+// Defaults of HTTPLinkChecker for fields left zero.
+const (
+	defaultLinkCheckConcurrency  = 8
+	defaultLinkCheckHostInterval = time.Second
+	defaultLinkCheckAttempts     = 3
+	defaultLinkCheckBackoff      = 2 * time.Second
+	linkCheckTimeout             = 15 * time.Second
+	// maxLinkCheckRetryAfter caps how long a Retry-After header can hold a check.
+	maxLinkCheckRetryAfter = 30 * time.Second
+	// maxLinkCheckBodySize is how much of a response body is read before the connection is closed.
+	maxLinkCheckBodySize = 64 << 10
+	linkCheckUserAgent   = "Mozilla/5.0 (compatible; devsearch-go link checker)"
+)
+
+// errNonPublicAddress is returned for links resolving to loopback, private or other addresses
+// that are not on the public internet.
+var errNonPublicAddress = errors.New("the link points to an address that is not public")
+
+// HTTPLinkChecker implements the application.LinkChecker interface with HTTP GET requests. It
+// runs up to Concurrency checks at once, waits HostInterval between requests to the same host,
+// and retries network errors, 5xx and 429 responses up to MaxAttempts times, doubling Backoff
+// after each try or waiting as long as Retry-After asks. Zero fields take their defaults.
+// Links resolving to non-public addresses fail unless AllowPrivateAddresses is set.
+type HTTPLinkChecker struct {
+	Concurrency           int
+	HostInterval          time.Duration
+	MaxAttempts           int
+	Backoff               time.Duration
+	AllowPrivateAddresses bool
+
+	clientOnce sync.Once
+	client     *http.Client
+}
+
+// CheckLinks checks links and returns their results in the same order.
+func (c *HTTPLinkChecker) CheckLinks(links []string) []application.LinkCheckResult {
+	concurrency := c.Concurrency
+	if concurrency <= 0 {
+		concurrency = defaultLinkCheckConcurrency
+	}
+	hosts := &hostPacer{interval: c.HostInterval, next: make(map[string]time.Time)}
+	if hosts.interval <= 0 {
+		hosts.interval = defaultLinkCheckHostInterval
+	}
+
+	results := make([]application.LinkCheckResult, len(links))
+	slots := make(chan struct{}, concurrency)
+	var wg sync.WaitGroup
+	for i, link := range links {
+		wg.Add(1)
+		slots <- struct{}{}
+		go func(i int, link string) {
+			defer func() { <-slots; wg.Done() }()
+			results[i] = c.checkLink(link, hosts)
+		}(i, link)
+	}
+	wg.Wait()
+	return results
+}
+
+// checkLink checks one link, retrying failures that may be temporary.
+func (c *HTTPLinkChecker) checkLink(link string, hosts *hostPacer) application.LinkCheckResult {
+	result := application.LinkCheckResult{URL: link}
+	parsed, err := url.Parse(link)
+	if err != nil || parsed.Host == "" {
+		result.Err = "not a valid web address"
+		return result
+	}
+	attempts := c.MaxAttempts
+	if attempts <= 0 {
+		attempts = defaultLinkCheckAttempts
+	}
+	backoff := c.Backoff
+	if backoff <= 0 {
+		backoff = defaultLinkCheckBackoff
+	}
+
+	started := time.Now()
+	for attempt := 1; attempt <= attempts; attempt++ {
+		hosts.wait(strings.ToLower(parsed.Host))
+		result.Attempts = attempt
+		statusCode, retryAfter, err := c.get(link)
+		result.StatusCode = statusCode
+		result.Err = ""
+		if err != nil {
+			result.Err = linkCheckError(err)
+		}
+
+		retryable := (err != nil && !permanentLinkError(err)) ||
+			(statusCode >= 500 && statusCode < 600) || statusCode == http.StatusTooManyRequests
+		if !retryable || attempt == attempts {
+			break
+		}
+		delay := backoff << (attempt - 1)
+		if retryAfter > 0 {
+			delay = min(retryAfter, maxLinkCheckRetryAfter)
+		}
+		time.Sleep(delay)
+	}
+	result.Duration = time.Since(started)
+	return result
+}
+
+// get requests a link and returns the status of the final response after redirects, and how
+// long the site asked to wait before retrying, if it did.
+func (c *HTTPLinkChecker) get(link string) (statusCode int, retryAfter time.Duration, err error) {
+	req, err := http.NewRequest(http.MethodGet, link, nil)
+	if err != nil {
+		return 0, 0, err
+	}
+	req.Header.Set("User-Agent", linkCheckUserAgent)
+	req.Header.Set("Accept", "text/html,application/xhtml+xml,*/*;q=0.8")
+
+	resp, err := c.httpClient().Do(req)
+	if err != nil {
+		return 0, 0, err
+	}
+	defer resp.Body.Close()
+	// Reading a little of the body lets servers that only fail while streaming show it
+	io.Copy(io.Discard, io.LimitReader(resp.Body, maxLinkCheckBodySize))
+
+	if seconds, err := strconv.Atoi(resp.Header.Get("Retry-After")); err == nil && seconds > 0 {
+		retryAfter = time.Duration(seconds) * time.Second
+	}
+	return resp.StatusCode, retryAfter, nil
+}
+
+// httpClient returns the client checks are made with. It ignores proxy settings, so the check
+// sees what visitors see, and refuses to connect to non-public addresses, including after a
+// redirect, unless AllowPrivateAddresses is set.
+func (c *HTTPLinkChecker) httpClient() *http.Client {
+	c.clientOnce.Do(func() {
+		dialer := &net.Dialer{Timeout: linkCheckTimeout}
+		if !c.AllowPrivateAddresses {
+			dialer.Control = func(network, address string, _ syscall.RawConn) error {
+				host, _, err := net.SplitHostPort(address)
+				if err != nil {
+					return err
+				}
+				if ip := net.ParseIP(host); ip == nil || !isPublicIP(ip) {
+					return errNonPublicAddress
+				}
+				return nil
+			}
+		}
+		transport := http.DefaultTransport.(*http.Transport).Clone()
+		transport.Proxy = nil
+		transport.DialContext = dialer.DialContext
+		c.client = &http.Client{Timeout: linkCheckTimeout, Transport: transport}
+	})
+	return c.client
+}
+
+// isPublicIP reports whether an address is on the public internet.
+func isPublicIP(ip net.IP) bool {
+	return ip.IsGlobalUnicast() && !ip.IsPrivate() && !ip.IsLoopback() && !ip.IsLinkLocalUnicast() &&
+		!ip.Equal(net.IPv4bcast) && !isSharedAddress(ip)
+}
+
+// isSharedAddress reports whether an address is in the carrier-grade NAT range 100.64.0.0/10.
+func isSharedAddress(ip net.IP) bool {
+	ip4 := ip.To4()
+	return ip4 != nil && ip4[0] == 100 && ip4[1]&0xc0 == 64
+}
+
+// permanentLinkError reports whether a request failed in a way retrying cannot fix: the
+// address is not public or the domain does not exist.
+func permanentLinkError(err error) bool {
+	var dnsErr *net.DNSError
+	return errors.Is(err, errNonPublicAddress) || (errors.As(err, &dnsErr) && dnsErr.IsNotFound)
+}
+
+// linkCheckError describes why a request got no response, without the request details Go
+// wraps network errors in.
+func linkCheckError(err error) string {
+	var dnsErr *net.DNSError
+	switch {
+	case errors.Is(err, errNonPublicAddress):
+		return errNonPublicAddress.Error()
+	case errors.As(err, &dnsErr):
+		return "the domain name does not resolve"
+	case errors.Is(err, context.DeadlineExceeded), errors.Is(err, syscall.ETIMEDOUT):
+		return "the site did not answer in time"
+	case errors.Is(err, syscall.ECONNREFUSED):
+		return "the site refused the connection"
+	}
+	var netErr net.Error
+	if errors.As(err, &netErr) && netErr.Timeout() {
+		return "the site did not answer in time"
+	}
+	var urlErr *url.Error
+	if errors.As(err, &urlErr) {
+		return fmt.Sprintf("request failed: %v", urlErr.Err)
+	}
+	return err.Error()
+}
+
+// hostPacer spaces out requests to the same host.
+type hostPacer struct {
+	interval time.Duration
+	mu       sync.Mutex
+	next     map[string]time.Time
+}
+
+// wait blocks until a request to host may be made, and reserves that moment for it.
+func (p *hostPacer) wait(host string) {
+	p.mu.Lock()
+	now := time.Now()
+	at := p.next[host]
+	if at.Before(now) {
+		at = now
+	}
+	p.next[host] = at.Add(p.interval)
+	p.mu.Unlock()
+	time.Sleep(time.Until(at))
+}
@@ -0,0 +1,139 @@
+package infrastructure
+
+import (
+	"devsearch-go/internal/application"
+	"devsearch-go/internal/domain"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// normalizedTagName is the SQL counterpart of domain.NormalizeSkillName for tag names.
+const normalizedTagName = "regexp_replace(LOWER(tags.name), '[^[:alnum:]#+]', '', 'g')"
+
+// GormJobPostingRepository implements the application.JobPostingRepository interface using GORM.
+type GormJobPostingRepository struct {
+	DB *gorm.DB
+}
+
+// preloadJobPosting loads the owner and the required skills with their catalog entries.
+func (r *GormJobPostingRepository) preloadJobPosting() *gorm.DB {
+	return r.DB.Preload("Owner").Preload("RequiredSkills", func(db *gorm.DB) *gorm.DB {
+		return db.Order("name ASC")
+	}).Preload("RequiredSkills.CatalogSkill.Aliases")
+}
+
+// FindJobPostings retrieves job postings matching the filter, newest first, with pagination.
+func (r *GormJobPostingRepository) FindJobPostings(filter application.JobPostingFilter, page, limit int) ([]domain.JobPosting, int64, error) {
+	var postings []domain.JobPosting
+	query := r.preloadJobPosting()
+
+	if searchQuery := filter.Query; searchQuery != "" {
+		like := "%" + searchQuery + "%"
+		// Skill matches go through the catalog so that aliases ("golang") find canonical skills ("Go").
+		key := domain.NormalizeSkillName(searchQuery)
+		query = query.Where(r.DB.Where("title ILIKE ? OR company ILIKE ? OR description ILIKE ?", like, like, like).
+			Or("id IN (SELECT job_posting_id FROM job_posting_skills WHERE catalog_skill_id IN (SELECT id FROM catalog_skills WHERE slug = ? UNION SELECT catalog_skill_id FROM skill_aliases WHERE alias = ?))", key, key))
+	}
+	if filter.Location != "" {
+		query = query.Where("location ILIKE ?", "%"+filter.Location+"%")
+	}
+	if filter.RemoteOnly {
+		query = query.Where("remote = ?", true)
+	}
+
+	var totalPostings int64
+	query.Model(&domain.JobPosting{}).Count(&totalPostings)
+
+	offset := (page - 1) * limit
+	err := query.Order("created_at DESC").Limit(limit).Offset(offset).Find(&postings).Error
+	if err != nil {
+		return nil, 0, err
+	}
+	return postings, totalPostings, nil
+}
+
+// FindJobPostingByID retrieves a single job posting by its ID.
+func (r *GormJobPostingRepository) FindJobPostingByID(id uuid.UUID) (*domain.JobPosting, error) {
+	var posting domain.JobPosting
+	if err := r.preloadJobPosting().First(&posting, "id = ?", id).Error; err != nil {
+		return nil, err
+	}
+	return &posting, nil
+}
+
+// FindJobPostingsByOwner retrieves the job postings a profile published, newest first.
+func (r *GormJobPostingRepository) FindJobPostingsByOwner(ownerID uuid.UUID) ([]domain.JobPosting, error) {
+	var postings []domain.JobPosting
+	if err := r.preloadJobPosting().Where("owner_id = ?", ownerID).Order("created_at DESC").Find(&postings).Error; err != nil {
+		return nil, err
+	}
+	return postings, nil
+}
+
+// FindJobPostingsRequiringSkills retrieves up to limit job postings, newest first, that require one
+// of the catalog skills or a catalog skill whose slug or alias equals one of the tag keys.
+func (r *GormJobPostingRepository) FindJobPostingsRequiringSkills(catalogSkillIDs []uuid.UUID, tagKeys []string, limit int) ([]domain.JobPosting, error) {
+	var postings []domain.JobPosting
+	err := r.preloadJobPosting().
+		Where("id IN (SELECT job_posting_id FROM job_posting_skills WHERE catalog_skill_id IN ? OR catalog_skill_id IN (SELECT id FROM catalog_skills WHERE slug IN ? UNION SELECT catalog_skill_id FROM skill_aliases WHERE alias IN ?))",
+			catalogSkillIDs, tagKeys, tagKeys).
+		Order("created_at DESC").Limit(limit).Find(&postings).Error
+	if err != nil {
+		return nil, err
+	}
+	return postings, nil
+}
+
+// FindProfilesWithSkillsOrTags retrieves up to limit public profiles that list one of the catalog
+// skills or own a published, visible project with a tag whose normalized name is one of the tag
+// keys. Skills and the tags of published, visible projects are loaded for scoring.
+func (r *GormJobPostingRepository) FindProfilesWithSkillsOrTags(catalogSkillIDs []uuid.UUID, tagKeys []string, limit int) ([]domain.Profile, error) {
+	var profiles []domain.Profile
+	err := r.DB.Preload("Skills").
+		Preload("Projects", "status = ? AND hidden_at IS NULL", domain.ProjectStatusPublished).Preload("Projects.Tags").
+		Where("privacy_mode = ?", domain.ProfileModePublic).
+		Where(r.DB.Where("id IN (SELECT owner_id FROM skills WHERE catalog_skill_id IN ? AND deleted_at IS NULL)", catalogSkillIDs).
+			Or("user_id IN (SELECT projects.owner_id FROM projects JOIN project_tags ON project_tags.project_id = projects.id JOIN tags ON tags.id = project_tags.tag_id WHERE projects.status = ? AND projects.hidden_at IS NULL AND projects.deleted_at IS NULL AND "+normalizedTagName+" IN ?)",
+				domain.ProjectStatusPublished, tagKeys)).
+		Order("updated_at DESC").Limit(limit).Find(&profiles).Error
+	if err != nil {
+		return nil, err
+	}
+	return profiles, nil
+}
+
+// CreateJobPosting creates a new job posting together with its required skills.
+func (r *GormJobPostingRepository) CreateJobPosting(posting *domain.JobPosting) error {
+	return r.DB.Omit("Owner", "RequiredSkills.CatalogSkill").Create(posting).Error
+}
+
+// UpdateJobPosting updates an existing job posting and replaces its required skills.
+func (r *GormJobPostingRepository) UpdateJobPosting(posting *domain.JobPosting) error {
+	return r.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Omit("Owner", "RequiredSkills").Save(posting).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("job_posting_id = ?", posting.ID).Delete(&domain.JobPostingSkill{}).Error; err != nil {
+			return err
+		}
+		for i := range posting.RequiredSkills {
+			skill := &posting.RequiredSkills[i]
+			skill.JobPostingID = posting.ID
+			if err := tx.Omit("CatalogSkill").Create(skill).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// DeleteJobPosting deletes a job posting by its ID together with its required skills.
+func (r *GormJobPostingRepository) DeleteJobPosting(id uuid.UUID) error {
+	return r.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("job_posting_id = ?", id).Delete(&domain.JobPostingSkill{}).Error; err != nil {
+			return err
+		}
+		return tx.Delete(&domain.JobPosting{}, "id = ?", id).Error
+	})
+}
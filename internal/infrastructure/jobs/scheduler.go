@@ -0,0 +1,54 @@
+package jobs
+
+import (
+	"context"
+	"log"
+	"time"
+)
+
+// job is a named task run at a fixed interval.
+type job struct {
+	name     string
+	interval time.Duration
+	run      func() error
+}
+
+// Scheduler runs background jobs at fixed intervals until its context is cancelled.
+type Scheduler struct {
+	jobs []job
+}
+
+// NewScheduler creates a Scheduler without jobs.
+func NewScheduler() *Scheduler {
+	return &Scheduler{}
+}
+
+// Every registers a job that runs once when the scheduler starts and then every interval.
+func (s *Scheduler) Every(interval time.Duration, name string, run func() error) {
+	s.jobs = append(s.jobs, job{name: name, interval: interval, run: run})
+}
+
+// Start runs every registered job in its own goroutine and returns immediately.
+// A run of a job never overlaps with the previous run of the same job.
+func (s *Scheduler) Start(ctx context.Context) {
+	for _, j := range s.jobs {
+		go s.loop(ctx, j)
+	}
+}
+
+// loop runs a job until the context is cancelled, logging failures instead of stopping.
+func (s *Scheduler) loop(ctx context.Context, j job) {
+	ticker := time.NewTicker(j.interval)
+	defer ticker.Stop()
+
+	for {
+		if err := j.run(); err != nil {
+			log.Printf("Background job %s failed: %v", j.name, err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,154 @@
+package infrastructure
+
+import (
+	"devsearch-go/internal/application"
+	"devsearch-go/internal/domain"
+
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// checkedLinkColumns lists the link columns that are checked, with the page they belong to. from
+// is the table joined with the profile of its owner, since inboxes are addressed by profile.
+var checkedLinkColumns = []struct {
+	kind, table, from, titleColumn, ownerColumn, field string
+}{
+	{domain.LinkKindProfile, "profiles", "profiles", "name", "user_id", domain.LinkFieldGithub},
+	{domain.LinkKindProfile, "profiles", "profiles", "name", "user_id", domain.LinkFieldLinkedin},
+	{domain.LinkKindProfile, "profiles", "profiles", "name", "user_id", domain.LinkFieldWebsite},
+	{domain.LinkKindProject, "projects", "projects JOIN profiles ON profiles.user_id = projects.owner_id", "title", "owner_id", domain.LinkFieldDemo},
+	{domain.LinkKindProject, "projects", "projects JOIN profiles ON profiles.user_id = projects.owner_id", "title", "owner_id", domain.LinkFieldSource},
+}
+
+// linkRow is a row of currentLinksQuery.
+type linkRow struct {
+	Kind           string
+	TargetID       uuid.UUID
+	TargetTitle    string
+	OwnerID        uuid.UUID
+	OwnerProfileID uuid.UUID
+	Field          string
+	URL            string
+}
+
+// GormLinkHealthRepository implements the application.LinkHealthRepository interface using GORM.
+type GormLinkHealthRepository struct {
+	DB *gorm.DB
+}
+
+// FindLinksDueForCheck retrieves the current links whose status is missing, stale or stored
+// for another link, least recently checked first.
+func (r *GormLinkHealthRepository) FindLinksDueForCheck(healthyBefore, failingBefore time.Time, limit int) ([]application.LinkTarget, error) {
+	query, args := currentLinksQuery()
+	var rows []linkRow
+	err := r.DB.Raw(`SELECT links.* FROM (`+query+`) AS links
+		LEFT JOIN link_statuses ON link_statuses.kind = links.kind AND link_statuses.target_id = links.target_id AND link_statuses.field = links.field
+		WHERE link_statuses.target_id IS NULL OR link_statuses.url <> links.url
+			OR (link_statuses.consecutive_failures = 0 AND link_statuses.checked_at < ?)
+			OR (link_statuses.consecutive_failures > 0 AND link_statuses.checked_at < ?)
+		ORDER BY link_statuses.checked_at ASC NULLS FIRST LIMIT ?`,
+		append(args, healthyBefore, failingBefore, limit)...).Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+	return r.withLinkStatuses(rows)
+}
+
+// FindOwnerLinks retrieves the current links of a user's profile and projects, profile first.
+func (r *GormLinkHealthRepository) FindOwnerLinks(ownerID uuid.UUID) ([]application.LinkTarget, error) {
+	query, args := currentLinksQuery()
+	var rows []linkRow
+	err := r.DB.Raw(`SELECT * FROM (`+query+`) AS links WHERE owner_id = ? ORDER BY kind ASC, target_title ASC, target_id, field`,
+		append(args, ownerID)...).Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+	return r.withLinkStatuses(rows)
+}
+
+// FindLinkChecks retrieves the checks of a user's links since a time, newest first.
+func (r *GormLinkHealthRepository) FindLinkChecks(ownerID uuid.UUID, since time.Time) ([]domain.LinkCheck, error) {
+	var checks []domain.LinkCheck
+	err := r.DB.Where("owner_id = ? AND checked_at >= ?", ownerID, since).Order("checked_at DESC").Find(&checks).Error
+	return checks, err
+}
+
+// SaveLinkCheck stores the status of a link, replacing the one stored for its field, and adds
+// the check to its history.
+func (r *GormLinkHealthRepository) SaveLinkCheck(status *domain.LinkStatus, check *domain.LinkCheck) error {
+	return r.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "kind"}, {Name: "target_id"}, {Name: "field"}},
+			UpdateAll: true,
+		}).Create(status).Error; err != nil {
+			return err
+		}
+		return tx.Create(check).Error
+	})
+}
+
+// DeleteLinkChecksBefore deletes the history of link checks made before a time.
+func (r *GormLinkHealthRepository) DeleteLinkChecksBefore(before time.Time) error {
+	return r.DB.Where("checked_at < ?", before).Delete(&domain.LinkCheck{}).Error
+}
+
+// withLinkStatuses turns link rows into link targets with the status stored for their field.
+func (r *GormLinkHealthRepository) withLinkStatuses(rows []linkRow) ([]application.LinkTarget, error) {
+	if len(rows) == 0 {
+		return nil, nil
+	}
+	ids := make([]uuid.UUID, 0, len(rows))
+	for _, row := range rows {
+		ids = append(ids, row.TargetID)
+	}
+	var statuses []domain.LinkStatus
+	if err := r.DB.Where("target_id IN ?", ids).Find(&statuses).Error; err != nil {
+		return nil, err
+	}
+	byField := make(map[string]*domain.LinkStatus, len(statuses))
+	for i := range statuses {
+		byField[statuses[i].Kind+"|"+statuses[i].TargetID.String()+"|"+statuses[i].Field] = &statuses[i]
+	}
+
+	targets := make([]application.LinkTarget, len(rows))
+	for i, row := range rows {
+		targets[i] = application.LinkTarget{
+			Kind:           row.Kind,
+			TargetID:       row.TargetID,
+			TargetTitle:    row.TargetTitle,
+			OwnerID:        row.OwnerID,
+			OwnerProfileID: row.OwnerProfileID,
+			Field:          row.Field,
+			URL:            row.URL,
+			Status:         byField[row.Kind+"|"+row.TargetID.String()+"|"+row.Field],
+		}
+	}
+	return targets, nil
+}
+
+// currentLinksQuery returns a query selecting the checked links currently set on profiles and
+// projects outside the trash, as linkRow columns, with its arguments.
+func currentLinksQuery() (string, []interface{}) {
+	var parts []string
+	var args []interface{}
+	for _, column := range checkedLinkColumns {
+		table := column.table + "."
+		parts = append(parts, "SELECT CAST(? AS text) AS kind, "+table+"id AS target_id, "+table+column.titleColumn+" AS target_title, "+
+			table+column.ownerColumn+" AS owner_id, profiles.id AS owner_profile_id, CAST(? AS text) AS field, "+
+			table+column.field+" AS url FROM "+column.from+" WHERE "+table+"deleted_at IS NULL AND "+table+column.field+" <> ''")
+		args = append(args, column.kind, column.field)
+	}
+	return strings.Join(parts, " UNION ALL "), args
+}
+
+// purgeLinkHealth deletes the link statuses and link history of a profile or project.
+func purgeLinkHealth(tx *gorm.DB, kind string, targetID uuid.UUID) error {
+	if err := tx.Where("kind = ? AND target_id = ?", kind, targetID).Delete(&domain.LinkStatus{}).Error; err != nil {
+		return err
+	}
+	return tx.Where("kind = ? AND target_id = ?", kind, targetID).Delete(&domain.LinkCheck{}).Error
+}
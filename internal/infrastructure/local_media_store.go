@@ -0,0 +1,27 @@
+package infrastructure
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// LocalMediaStore implements the application.MediaStore interface on the local media directory.
+type LocalMediaStore struct {
+	Dir string
+}
+
+// DeleteMedia removes an uploaded file. Names that would leave the media directory are refused,
+// and a file that is already gone is not an error.
+func (s *LocalMediaStore) DeleteMedia(name string) error {
+	clean := filepath.Clean(filepath.FromSlash(name))
+	if filepath.IsAbs(clean) || clean == ".." || strings.HasPrefix(clean, ".."+string(filepath.Separator)) {
+		return fmt.Errorf("invalid media name %q", name)
+	}
+	if err := os.Remove(filepath.Join(s.Dir, clean)); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+	return nil
+}
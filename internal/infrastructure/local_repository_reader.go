@@ -0,0 +1,304 @@
+package infrastructure
+
+import (
+	"devsearch-go/internal/application"
+
+	"archive/tar"
+	"archive/zip"
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Limits on what LocalRepositoryReader reads, so a hostile archive or repository cannot
+// exhaust memory or time.
+const (
+	maxRepositoryFiles        = 100000
+	maxRepositoryMetadataSize = 256 << 10
+	gitCommandTimeout         = 30 * time.Second
+)
+
+// LocalRepositoryReader implements the application.RepositoryReader interface for uploaded
+// archives and for repositories on a local git mirror, which it reads with the git command.
+// Importing from the mirror is disabled while MirrorDir is empty.
+type LocalRepositoryReader struct {
+	MirrorDir string
+}
+
+// ReadArchive reads a zip or gzipped tar archive of a repository without extracting it. A
+// single directory wrapping everything, as in the archives code hosts offer for download, is
+// taken as the repository root and names the repository.
+func (r *LocalRepositoryReader) ReadArchive(archive io.ReaderAt, size int64, filename string) (*application.RepositoryContents, error) {
+	lower := strings.ToLower(filename)
+	var name string
+	var files []application.RepositoryFile
+	var texts map[string]string
+	var err error
+	switch {
+	case strings.HasSuffix(lower, ".zip"):
+		name = filename[:len(filename)-len(".zip")]
+		files, texts, err = readZipArchive(archive, size)
+	case strings.HasSuffix(lower, ".tar.gz"):
+		name = filename[:len(filename)-len(".tar.gz")]
+		files, texts, err = readTarArchive(io.NewSectionReader(archive, 0, size))
+	case strings.HasSuffix(lower, ".tgz"):
+		name = filename[:len(filename)-len(".tgz")]
+		files, texts, err = readTarArchive(io.NewSectionReader(archive, 0, size))
+	default:
+		return nil, application.ErrUnsupportedArchive
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	contents := &application.RepositoryContents{Name: path.Base(filepath.ToSlash(name)), Metadata: make(map[string]string)}
+	root := archiveRoot(files)
+	if root != "" {
+		contents.Name = root
+	}
+	for _, file := range files {
+		relative := strings.TrimPrefix(file.Path, root+"/")
+		if root == "" {
+			relative = file.Path
+		}
+		contents.Files = append(contents.Files, application.RepositoryFile{Path: relative, Size: file.Size})
+		if text, ok := texts[file.Path]; ok && !strings.Contains(relative, "/") {
+			if metadataName := repositoryMetadataName(relative); metadataName != "" {
+				contents.Metadata[metadataName] = text
+			}
+		}
+	}
+	return contents, nil
+}
+
+// readZipArchive lists the files of a zip archive and reads the ones that may be metadata files.
+func readZipArchive(archive io.ReaderAt, size int64) ([]application.RepositoryFile, map[string]string, error) {
+	reader, err := zip.NewReader(archive, size)
+	if err != nil {
+		return nil, nil, application.ErrUnsupportedArchive
+	}
+	if len(reader.File) > maxRepositoryFiles {
+		return nil, nil, fmt.Errorf("the archive has more than %d files", maxRepositoryFiles)
+	}
+
+	var files []application.RepositoryFile
+	texts := make(map[string]string)
+	for _, entry := range reader.File {
+		if entry.FileInfo().IsDir() {
+			continue
+		}
+		files = append(files, application.RepositoryFile{Path: entry.Name, Size: int64(entry.UncompressedSize64)})
+		if !isMetadataCandidate(entry.Name) {
+			continue
+		}
+		src, err := entry.Open()
+		if err != nil {
+			continue
+		}
+		text, err := io.ReadAll(io.LimitReader(src, maxRepositoryMetadataSize))
+		src.Close()
+		if err == nil {
+			texts[entry.Name] = string(text)
+		}
+	}
+	return files, texts, nil
+}
+
+// readTarArchive lists the files of a gzipped tar archive and reads the ones that may be metadata files.
+func readTarArchive(archive io.Reader) ([]application.RepositoryFile, map[string]string, error) {
+	gz, err := gzip.NewReader(archive)
+	if err != nil {
+		return nil, nil, application.ErrUnsupportedArchive
+	}
+	defer gz.Close()
+
+	var files []application.RepositoryFile
+	texts := make(map[string]string)
+	reader := tar.NewReader(gz)
+	for {
+		header, err := reader.Next()
+		if err == io.EOF {
+			return files, texts, nil
+		}
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to read archive: %w", err)
+		}
+		if header.Typeflag != tar.TypeReg {
+			continue
+		}
+		if len(files) == maxRepositoryFiles {
+			return nil, nil, fmt.Errorf("the archive has more than %d files", maxRepositoryFiles)
+		}
+		name := strings.TrimPrefix(header.Name, "./")
+		files = append(files, application.RepositoryFile{Path: name, Size: header.Size})
+		if isMetadataCandidate(name) {
+			text, err := io.ReadAll(io.LimitReader(reader, maxRepositoryMetadataSize))
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to read archive: %w", err)
+			}
+			texts[name] = string(text)
+		}
+	}
+}
+
+// isMetadataCandidate reports whether an archive entry is a metadata file at the top of the
+// archive or of the single directory that may wrap it.
+func isMetadataCandidate(name string) bool {
+	return strings.Count(name, "/") <= 1 && repositoryMetadataName(path.Base(name)) != ""
+}
+
+// archiveRoot returns the directory every file of an archive lies in, or "" when there is none.
+func archiveRoot(files []application.RepositoryFile) string {
+	root := ""
+	for _, file := range files {
+		dir, _, found := strings.Cut(file.Path, "/")
+		if !found || (root != "" && dir != root) {
+			return ""
+		}
+		root = dir
+	}
+	return root
+}
+
+// repositoryMetadataName returns the name a file has in application.RepositoryMetadataFiles,
+// or "" when it is not one of them.
+func repositoryMetadataName(name string) string {
+	for _, metadataName := range application.RepositoryMetadataFiles {
+		if strings.EqualFold(name, metadataName) {
+			return metadataName
+		}
+	}
+	return ""
+}
+
+// ReadMirror reads a repository on the local git mirror, by its path relative to MirrorDir. Bare
+// mirrors and working copies both work; the files are read from HEAD.
+func (r *LocalRepositoryReader) ReadMirror(repositoryPath string) (*application.RepositoryContents, error) {
+	dir, err := r.mirrorRepositoryDir(repositoryPath)
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), gitCommandTimeout)
+	defer cancel()
+
+	if _, err := runGit(ctx, dir, "rev-parse", "--verify", "HEAD"); err != nil {
+		return nil, application.ErrMirrorRepositoryNotFound
+	}
+
+	contents := &application.RepositoryContents{
+		Name:     strings.TrimSuffix(filepath.Base(dir), ".git"),
+		Metadata: make(map[string]string),
+	}
+	if contents.Files, err = gitTreeFiles(ctx, dir); err != nil {
+		return nil, err
+	}
+	for _, file := range contents.Files {
+		metadataName := repositoryMetadataName(file.Path)
+		if metadataName == "" || strings.Contains(file.Path, "/") || file.Size > maxRepositoryMetadataSize {
+			continue
+		}
+		if text, err := runGit(ctx, dir, "show", "HEAD:"+file.Path); err == nil {
+			contents.Metadata[metadataName] = string(text)
+		}
+	}
+
+	count, err := runGit(ctx, dir, "rev-list", "--count", "HEAD")
+	if err != nil {
+		return nil, fmt.Errorf("failed to count commits: %w", err)
+	}
+	contents.CommitCount, _ = strconv.Atoi(strings.TrimSpace(string(count)))
+	if remote, err := runGit(ctx, dir, "config", "--get", "remote.origin.url"); err == nil {
+		contents.RemoteURL = strings.TrimSpace(string(remote))
+	}
+	return contents, nil
+}
+
+// mirrorRepositoryDir resolves a path relative to the mirror, refusing paths that lead out of it,
+// including through symbolic links.
+func (r *LocalRepositoryReader) mirrorRepositoryDir(repositoryPath string) (string, error) {
+	if r.MirrorDir == "" {
+		return "", application.ErrMirrorImportDisabled
+	}
+	mirror, err := filepath.EvalSymlinks(r.MirrorDir)
+	if err != nil {
+		return "", fmt.Errorf("failed to open git mirror: %w", err)
+	}
+	clean := filepath.Clean(filepath.FromSlash(strings.TrimSpace(repositoryPath)))
+	if clean == "." || filepath.IsAbs(clean) || clean == ".." || strings.HasPrefix(clean, ".."+string(filepath.Separator)) {
+		return "", application.ErrMirrorRepositoryNotFound
+	}
+	dir, err := filepath.EvalSymlinks(filepath.Join(mirror, clean))
+	if err != nil || !strings.HasPrefix(dir, mirror+string(filepath.Separator)) {
+		return "", application.ErrMirrorRepositoryNotFound
+	}
+	if info, err := os.Stat(dir); err != nil || !info.IsDir() {
+		return "", application.ErrMirrorRepositoryNotFound
+	}
+	return dir, nil
+}
+
+// gitTreeFiles lists the files at HEAD with their sizes.
+func gitTreeFiles(ctx context.Context, dir string) ([]application.RepositoryFile, error) {
+	output, err := runGit(ctx, dir, "ls-tree", "-r", "-l", "-z", "--full-tree", "HEAD")
+	if err != nil {
+		return nil, fmt.Errorf("failed to list repository files: %w", err)
+	}
+
+	var files []application.RepositoryFile
+	scanner := bufio.NewScanner(bytes.NewReader(output))
+	scanner.Buffer(make([]byte, 64<<10), 1<<20)
+	scanner.Split(splitNUL)
+	for scanner.Scan() {
+		// Each entry is "<mode> <type> <object> <size>\t<path>".
+		meta, filePath, found := strings.Cut(scanner.Text(), "\t")
+		fields := strings.Fields(meta)
+		if !found || len(fields) != 4 || fields[1] != "blob" {
+			continue
+		}
+		size, _ := strconv.ParseInt(fields[3], 10, 64)
+		files = append(files, application.RepositoryFile{Path: filePath, Size: size})
+		if len(files) > maxRepositoryFiles {
+			return nil, fmt.Errorf("the repository has more than %d files", maxRepositoryFiles)
+		}
+	}
+	return files, scanner.Err()
+}
+
+// splitNUL is a bufio.SplitFunc for NUL-terminated records.
+func splitNUL(data []byte, atEOF bool) (advance int, token []byte, err error) {
+	if i := bytes.IndexByte(data, 0); i >= 0 {
+		return i + 1, data[:i], nil
+	}
+	if atEOF && len(data) > 0 {
+		return len(data), data, nil
+	}
+	return 0, nil, nil
+}
+
+// runGit runs a git command in a repository and returns its output.
+func runGit(ctx context.Context, dir string, args ...string) ([]byte, error) {
+	cmd := exec.CommandContext(ctx, "git", append([]string{"-C", dir}, args...)...)
+	cmd.Env = append(os.Environ(), "GIT_TERMINAL_PROMPT=0")
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	output, err := cmd.Output()
+	if err != nil {
+		var exitErr *exec.ExitError
+		if errors.As(err, &exitErr) {
+			return nil, fmt.Errorf("git %s: %s", args[0], strings.TrimSpace(stderr.String()))
+		}
+		return nil, err
+	}
+	return output, nil
+}
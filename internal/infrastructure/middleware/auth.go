@@ -3,8 +3,11 @@ package middleware
 import (
 	"net/http"
 
+	"devsearch-go/internal/domain"
+
 	"github.com/gin-contrib/sessions"
 	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
 )
 
 // AuthRequired is a middleware to check if the user is authenticated
@@ -22,3 +25,44 @@ func AuthRequired() gin.HandlerFunc {
 		c.Next()
 	}
 }
+
+// AccountStatusFunc returns the role of a user and whether their account is still active.
+type AccountStatusFunc func(userID uuid.UUID) (role string, active bool)
+
+// ActiveUser is a middleware that signs out users whose account was suspended or deleted, and
+// records the role of the signed-in user in the context under "userRole"
+func ActiveUser(status AccountStatusFunc) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		session := sessions.Default(c)
+		userIDStr, ok := session.Get("userID").(string)
+		if !ok {
+			c.Next()
+			return
+		}
+		userID, err := uuid.Parse(userIDStr)
+		role, active := "", false
+		if err == nil {
+			role, active = status(userID)
+		}
+		if !active {
+			session.Delete("userID")
+			session.Save()
+			c.Next()
+			return
+		}
+		c.Set("userRole", role)
+		c.Next()
+	}
+}
+
+// StaffRequired is a middleware to check if the user is a moderator or an admin
+func StaffRequired() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if !domain.IsStaffRole(c.GetString("userRole")) {
+			c.Redirect(http.StatusFound, "/")
+			c.Abort()
+			return
+		}
+		c.Next()
+	}
+}
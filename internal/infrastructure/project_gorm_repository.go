@@ -1,24 +1,32 @@
 package infrastructure
 
 import (
+	"devsearch-go/internal/application"
 	"devsearch-go/internal/domain"
 
+	"errors"
+	"time"
+
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgconn"
 	"gorm.io/gorm"
 )
 
+// projectSlugIndex is the unique index on the slugs of projects outside the trash.
+const projectSlugIndex = "idx_projects_live_slug"
+
 // GormProjectRepository implements the application.ProjectRepository interface using GORM.
 type GormProjectRepository struct {
 	DB *gorm.DB
 }
 
-// FindAllProjects retrieves all projects with optional search and pagination.
+// FindAllProjects retrieves the published projects with optional search and pagination.
 func (r *GormProjectRepository) FindAllProjects(searchQuery string, page, limit int) ([]domain.Project, int64, error) {
 	var projects []domain.Project
-	query := r.DB.Preload("Owner").Preload("Tags")
+	query := r.DB.Preload("Owner").Preload("Tags").Preload("SourceStats").Where("status = ? AND hidden_at IS NULL", domain.ProjectStatusPublished)
 
 	if searchQuery != "" {
-		query = query.Where("title ILIKE ? OR description ILIKE ?", "%"+searchQuery+"%", "%"+searchQuery+"%")
+		query = query.Where(r.DB.Where("title ILIKE ? OR description ILIKE ?", "%"+searchQuery+"%", "%"+searchQuery+"%"))
 	}
 
 	var totalProjects int64
@@ -35,27 +43,105 @@ func (r *GormProjectRepository) FindAllProjects(searchQuery string, page, limit
 // FindProjectByID retrieves a single project by its ID.
 func (r *GormProjectRepository) FindProjectByID(id uuid.UUID) (*domain.Project, error) {
 	var project domain.Project
-	if err := r.DB.Preload("Owner").Preload("Tags").Preload("Reviews.Owner").First(&project, "id = ?", id).Error; err != nil {
+	if err := r.DB.Preload("Owner").Preload("Tags").Preload("SourceStats").Preload("Reviews", "hidden_at IS NULL").Preload("Reviews.Owner.Profile").Preload("Collaborators.Profile").First(&project, "id = ?", id).Error; err != nil {
+		return nil, err
+	}
+	return &project, nil
+}
+
+// FindProjectBySlug retrieves a single project by its current slug.
+func (r *GormProjectRepository) FindProjectBySlug(slug string) (*domain.Project, error) {
+	var project domain.Project
+	if err := r.DB.Preload("Owner").Preload("Tags").Preload("SourceStats").Preload("Reviews", "hidden_at IS NULL").Preload("Reviews.Owner.Profile").Preload("Collaborators.Profile").First(&project, "slug = ?", slug).Error; err != nil {
 		return nil, err
 	}
 	return &project, nil
 }
 
+// FindProjectsWithoutSlug retrieves up to limit projects that have no slug yet.
+func (r *GormProjectRepository) FindProjectsWithoutSlug(limit int) ([]domain.Project, error) {
+	var projects []domain.Project
+	if err := r.DB.Where("slug = '' OR slug IS NULL").Order("created_at ASC").Limit(limit).Find(&projects).Error; err != nil {
+		return nil, err
+	}
+	return projects, nil
+}
+
+// UpdateProjectSlug sets the slug of a project without touching its other columns.
+func (r *GormProjectRepository) UpdateProjectSlug(id uuid.UUID, slug string) error {
+	return r.DB.Model(&domain.Project{}).Where("id = ?", id).Update("slug", slug).Error
+}
+
+// FindScheduledProjects retrieves drafts scheduled to be published before the given time.
+func (r *GormProjectRepository) FindScheduledProjects(publishBefore time.Time, limit int) ([]domain.Project, error) {
+	var projects []domain.Project
+	err := r.DB.Where("status = ? AND publish_at <= ?", domain.ProjectStatusDraft, publishBefore).
+		Order("publish_at ASC").Limit(limit).Find(&projects).Error
+	if err != nil {
+		return nil, err
+	}
+	return projects, nil
+}
+
+// PublishProject publishes a draft and clears its scheduled publication time.
+func (r *GormProjectRepository) PublishProject(id uuid.UUID) error {
+	return r.DB.Model(&domain.Project{}).Where("id = ? AND status = ?", id, domain.ProjectStatusDraft).
+		Updates(map[string]interface{}{"status": domain.ProjectStatusPublished, "publish_at": nil}).Error
+}
+
 // CreateProject creates a new project.
 func (r *GormProjectRepository) CreateProject(project *domain.Project) error {
-	return r.DB.Create(project).Error
+	return translateSlugConflict(r.DB.Create(project).Error)
 }
 
-// UpdateProject updates an existing project.
+// UpdateProject updates an existing project. Collaborators are managed separately and left untouched.
 func (r *GormProjectRepository) UpdateProject(project *domain.Project) error {
-	return r.DB.Save(project).Error
+	return translateSlugConflict(r.DB.Omit("Collaborators", "SourceStats").Save(project).Error)
 }
 
-// DeleteProject deletes a project by its ID.
+// translateSlugConflict turns a violation of the project slug index into application.ErrProjectSlugTaken.
+func translateSlugConflict(err error) error {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == "23505" && pgErr.ConstraintName == projectSlugIndex {
+		return application.ErrProjectSlugTaken
+	}
+	return err
+}
+
+// DeleteProject moves a project to the trash by its ID.
 func (r *GormProjectRepository) DeleteProject(id uuid.UUID) error {
 	return r.DB.Delete(&domain.Project{}, "id = ?", id).Error
 }
 
+// FindReview retrieves the review a user left on a project.
+func (r *GormProjectRepository) FindReview(projectID, ownerID uuid.UUID) (*domain.Review, error) {
+	var review domain.Review
+	if err := r.DB.Where("project_id = ? AND owner_id = ?", projectID, ownerID).First(&review).Error; err != nil {
+		return nil, err
+	}
+	return &review, nil
+}
+
+// CreateReview creates a new project review.
+func (r *GormProjectRepository) CreateReview(review *domain.Review) error {
+	return r.DB.Create(review).Error
+}
+
+// CountReviewVotes counts all votes and the up votes a project received, leaving out hidden reviews.
+func (r *GormProjectRepository) CountReviewVotes(projectID uuid.UUID) (total, up int64, err error) {
+	if err = r.DB.Model(&domain.Review{}).Where("project_id = ? AND hidden_at IS NULL", projectID).Count(&total).Error; err != nil {
+		return 0, 0, err
+	}
+	err = r.DB.Model(&domain.Review{}).Where("project_id = ? AND hidden_at IS NULL AND value = ?", projectID, domain.VoteUp).Count(&up).Error
+	return total, up, err
+}
+
+// UpdateProjectVotes sets the vote counters of a project without touching its other columns.
+func (r *GormProjectRepository) UpdateProjectVotes(id uuid.UUID, voteTotal, voteRatio int) error {
+	return r.DB.Model(&domain.Project{}).Where("id = ?", id).
+		Updates(map[string]interface{}{"vote_total": voteTotal, "vote_ratio": voteRatio}).Error
+}
+
 // FindOrCreateTag finds a tag by name or creates a new one if it doesn't exist.
 func (r *GormProjectRepository) FindOrCreateTag(tagName string) (*domain.Tag, error) {
 	var tag domain.Tag
@@ -0,0 +1,45 @@
+package infrastructure
+
+import (
+	"devsearch-go/internal/domain"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// GormProjectRevisionRepository implements the application.ProjectRevisionRepository interface using GORM.
+type GormProjectRevisionRepository struct {
+	DB *gorm.DB
+}
+
+// FindProjectRevisions retrieves the revisions of a project, newest first.
+func (r *GormProjectRevisionRepository) FindProjectRevisions(projectID uuid.UUID) ([]domain.ProjectRevision, error) {
+	var revisions []domain.ProjectRevision
+	if err := r.DB.Where("project_id = ?", projectID).Order("number DESC").Find(&revisions).Error; err != nil {
+		return nil, err
+	}
+	return revisions, nil
+}
+
+// FindProjectRevisionByID retrieves a single revision by its ID.
+func (r *GormProjectRevisionRepository) FindProjectRevisionByID(id uuid.UUID) (*domain.ProjectRevision, error) {
+	var revision domain.ProjectRevision
+	if err := r.DB.First(&revision, "id = ?", id).Error; err != nil {
+		return nil, err
+	}
+	return &revision, nil
+}
+
+// FindLatestProjectRevision retrieves the most recent revision of a project.
+func (r *GormProjectRevisionRepository) FindLatestProjectRevision(projectID uuid.UUID) (*domain.ProjectRevision, error) {
+	var revision domain.ProjectRevision
+	if err := r.DB.Where("project_id = ?", projectID).Order("number DESC").First(&revision).Error; err != nil {
+		return nil, err
+	}
+	return &revision, nil
+}
+
+// CreateProjectRevision creates a new revision.
+func (r *GormProjectRevisionRepository) CreateProjectRevision(revision *domain.ProjectRevision) error {
+	return r.DB.Create(revision).Error
+}
@@ -0,0 +1,90 @@
+package infrastructure
+
+import (
+	"devsearch-go/internal/domain"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// recommendationBatchSize bounds the number of rows inserted per statement when storing neighbours.
+const recommendationBatchSize = 500
+
+// GormRecommendationRepository implements the application.RecommendationRepository interface using GORM.
+type GormRecommendationRepository struct {
+	DB *gorm.DB
+}
+
+// FindProfilesForSimilarity retrieves all public, visible profiles with their skills and the tags
+// of their published, visible projects.
+func (r *GormRecommendationRepository) FindProfilesForSimilarity() ([]domain.Profile, error) {
+	var profiles []domain.Profile
+	err := r.DB.Select("id", "user_id").Preload("Skills").
+		Preload("Projects", "status = ? AND hidden_at IS NULL", domain.ProjectStatusPublished).Preload("Projects.Tags").
+		Where("privacy_mode = ? AND hidden_at IS NULL", domain.ProfileModePublic).Find(&profiles).Error
+	if err != nil {
+		return nil, err
+	}
+	return profiles, nil
+}
+
+// FindProjectsForSimilarity retrieves all published, visible projects with their titles, descriptions and tags.
+func (r *GormRecommendationRepository) FindProjectsForSimilarity() ([]domain.Project, error) {
+	var projects []domain.Project
+	if err := r.DB.Select("id", "title", "description").Preload("Tags").Where("status = ? AND hidden_at IS NULL", domain.ProjectStatusPublished).Find(&projects).Error; err != nil {
+		return nil, err
+	}
+	return projects, nil
+}
+
+// ReplaceSimilarProfiles replaces all stored similar profiles in a single transaction.
+func (r *GormRecommendationRepository) ReplaceSimilarProfiles(similar []domain.SimilarProfile) error {
+	return r.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("1 = 1").Delete(&domain.SimilarProfile{}).Error; err != nil {
+			return err
+		}
+		if len(similar) == 0 {
+			return nil
+		}
+		return tx.Omit("Neighbor").CreateInBatches(similar, recommendationBatchSize).Error
+	})
+}
+
+// ReplaceRelatedProjects replaces all stored related projects in a single transaction.
+func (r *GormRecommendationRepository) ReplaceRelatedProjects(related []domain.RelatedProject) error {
+	return r.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("1 = 1").Delete(&domain.RelatedProject{}).Error; err != nil {
+			return err
+		}
+		if len(related) == 0 {
+			return nil
+		}
+		return tx.Omit("Neighbor").CreateInBatches(related, recommendationBatchSize).Error
+	})
+}
+
+// FindSimilarProfiles retrieves up to limit stored neighbours of a profile, most similar first.
+// Neighbours that stopped being public or were hidden or deleted since the last refresh are left out.
+func (r *GormRecommendationRepository) FindSimilarProfiles(profileID uuid.UUID, limit int) ([]domain.SimilarProfile, error) {
+	var similar []domain.SimilarProfile
+	err := r.DB.Preload("Neighbor").
+		Where("profile_id = ? AND neighbor_id IN (SELECT id FROM profiles WHERE privacy_mode = ? AND hidden_at IS NULL AND deleted_at IS NULL)", profileID, domain.ProfileModePublic).
+		Order("score DESC").Limit(limit).Find(&similar).Error
+	if err != nil {
+		return nil, err
+	}
+	return similar, nil
+}
+
+// FindRelatedProjects retrieves up to limit stored neighbours of a project, most similar first.
+// Neighbours unpublished, hidden or deleted since the last refresh are skipped.
+func (r *GormRecommendationRepository) FindRelatedProjects(projectID uuid.UUID, limit int) ([]domain.RelatedProject, error) {
+	var related []domain.RelatedProject
+	err := r.DB.Preload("Neighbor.Owner").Preload("Neighbor.Tags").
+		Where("project_id = ? AND neighbor_id IN (SELECT id FROM projects WHERE status = ? AND hidden_at IS NULL AND deleted_at IS NULL)", projectID, domain.ProjectStatusPublished).
+		Order("score DESC").Limit(limit).Find(&related).Error
+	if err != nil {
+		return nil, err
+	}
+	return related, nil
+}
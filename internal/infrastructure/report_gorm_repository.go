@@ -0,0 +1,86 @@
+package infrastructure
+
+import (
+	"devsearch-go/internal/domain"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// GormReportRepository implements the application.ReportRepository interface using GORM.
+type GormReportRepository struct {
+	DB *gorm.DB
+}
+
+// preloadReportCase loads the reports of a case with their reporters, oldest first, and who resolved it.
+func (r *GormReportRepository) preloadReportCase() *gorm.DB {
+	return r.DB.Preload("Reports", func(db *gorm.DB) *gorm.DB {
+		return db.Order("created_at ASC")
+	}).Preload("Reports.Reporter").Preload("ResolvedBy")
+}
+
+// FindReportCaseByTarget retrieves the moderation case about a piece of content.
+func (r *GormReportRepository) FindReportCaseByTarget(kind string, targetID uuid.UUID) (*domain.ReportCase, error) {
+	var reportCase domain.ReportCase
+	if err := r.DB.Where("target_kind = ? AND target_id = ?", kind, targetID).First(&reportCase).Error; err != nil {
+		return nil, err
+	}
+	return &reportCase, nil
+}
+
+// FindReportCaseByID retrieves a single moderation case by its ID, with its reports.
+func (r *GormReportRepository) FindReportCaseByID(id uuid.UUID) (*domain.ReportCase, error) {
+	var reportCase domain.ReportCase
+	if err := r.preloadReportCase().First(&reportCase, "id = ?", id).Error; err != nil {
+		return nil, err
+	}
+	return &reportCase, nil
+}
+
+// FindReportCases retrieves the moderation cases in a state with their reports, the most
+// reported and then the most recently reported first, with pagination.
+func (r *GormReportRepository) FindReportCases(status string, page, limit int) ([]domain.ReportCase, int64, error) {
+	var reportCases []domain.ReportCase
+	query := r.preloadReportCase().Where("status = ?", status)
+
+	var totalCases int64
+	query.Model(&domain.ReportCase{}).Count(&totalCases)
+
+	offset := (page - 1) * limit
+	err := query.Order("report_count DESC, last_report_at DESC").Limit(limit).Offset(offset).Find(&reportCases).Error
+	if err != nil {
+		return nil, 0, err
+	}
+	return reportCases, totalCases, nil
+}
+
+// CountReportCases counts the moderation cases in a state.
+func (r *GormReportRepository) CountReportCases(status string) (int64, error) {
+	var count int64
+	err := r.DB.Model(&domain.ReportCase{}).Where("status = ?", status).Count(&count).Error
+	return count, err
+}
+
+// CreateReportCase creates a new moderation case.
+func (r *GormReportRepository) CreateReportCase(reportCase *domain.ReportCase) error {
+	return r.DB.Create(reportCase).Error
+}
+
+// UpdateReportCase updates a moderation case without touching its reports.
+func (r *GormReportRepository) UpdateReportCase(reportCase *domain.ReportCase) error {
+	return r.DB.Omit("Reports", "ResolvedBy").Save(reportCase).Error
+}
+
+// FindReport retrieves the report a user made in a moderation case.
+func (r *GormReportRepository) FindReport(caseID, reporterID uuid.UUID) (*domain.Report, error) {
+	var report domain.Report
+	if err := r.DB.Where("case_id = ? AND reporter_id = ?", caseID, reporterID).First(&report).Error; err != nil {
+		return nil, err
+	}
+	return &report, nil
+}
+
+// CreateReport creates a new report.
+func (r *GormReportRepository) CreateReport(report *domain.Report) error {
+	return r.DB.Create(report).Error
+}
@@ -0,0 +1,382 @@
+package infrastructure
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"devsearch-go/internal/application"
+	"devsearch-go/internal/domain"
+
+	"github.com/jung-kurt/gofpdf"
+)
+
+// Page geometry and colours shared by the resume layouts, in millimetres and RGB.
+const (
+	resumeMargin       = 18.0
+	resumeSidebarWidth = 62.0
+	resumeAvatarSize   = 30.0
+)
+
+var (
+	resumeAccent  = [3]int{44, 62, 80}
+	resumeMuted   = [3]int{110, 110, 110}
+	resumeSidebar = [3]int{236, 240, 245}
+)
+
+// GofpdfResumeRenderer implements the application.ResumeRenderer interface using gofpdf.
+type GofpdfResumeRenderer struct {
+	MediaDir string
+}
+
+// resumeWriter bundles a PDF document with the text translator for its core fonts.
+type resumeWriter struct {
+	pdf *gofpdf.Fpdf
+	tr  func(string) string
+}
+
+// RenderPDF writes the resume as an A4 PDF document in the given layout.
+func (r *GofpdfResumeRenderer) RenderPDF(w io.Writer, resume *application.ResumeData, layout string) error {
+	pdf := gofpdf.New("P", "mm", "A4", "")
+	doc := &resumeWriter{pdf: pdf, tr: pdf.UnicodeTranslatorFromDescriptor("")}
+
+	pdf.SetTitle(resume.Profile.Name+" - Resume", true)
+	pdf.SetCreator("DevSearch", true)
+	pdf.AliasNbPages("")
+	pdf.SetFooterFunc(func() {
+		pdf.SetY(-12)
+		pdf.SetFont("Helvetica", "I", 8)
+		doc.color(resumeMuted)
+		pdf.CellFormat(0, 6, doc.tr(fmt.Sprintf("%s - page %d of {nb}", resume.Profile.Name, pdf.PageNo())), "", 0, "C", false, 0, "")
+	})
+
+	switch layout {
+	case application.ResumeLayoutSidebar:
+		r.renderSidebar(doc, resume)
+	default:
+		r.renderClassic(doc, resume)
+	}
+
+	return pdf.Output(w)
+}
+
+// renderClassic renders a single column resume with the header on top.
+func (r *GofpdfResumeRenderer) renderClassic(doc *resumeWriter, resume *application.ResumeData) {
+	pdf := doc.pdf
+	pdf.SetMargins(resumeMargin, resumeMargin, resumeMargin)
+	pdf.SetAutoPageBreak(true, resumeMargin)
+	pdf.AddPage()
+
+	width := doc.contentWidth()
+	textX := resumeMargin
+	if avatar := r.registerAvatar(pdf, resume.Profile.ProfileImage); avatar != "" {
+		pdf.ImageOptions(avatar, resumeMargin, resumeMargin, resumeAvatarSize, resumeAvatarSize, false, gofpdf.ImageOptions{}, 0, "")
+		textX += resumeAvatarSize + 6
+	}
+
+	pdf.SetXY(textX, resumeMargin)
+	pdf.SetFont("Helvetica", "B", 22)
+	doc.color(resumeAccent)
+	pdf.CellFormat(0, 11, doc.tr(resume.Profile.Name), "", 2, "L", false, 0, "")
+	if resume.Profile.ShortIntro != "" {
+		pdf.SetFont("Helvetica", "", 12)
+		doc.color([3]int{0, 0, 0})
+		pdf.MultiCell(width-(textX-resumeMargin), 6, doc.tr(resume.Profile.ShortIntro), "", "L", false)
+		pdf.SetX(textX)
+	}
+	if resume.Profile.Location != "" {
+		pdf.SetFont("Helvetica", "", 10)
+		doc.color(resumeMuted)
+		pdf.CellFormat(0, 6, doc.tr(resume.Profile.Location), "", 2, "L", false, 0, "")
+	}
+	for _, link := range resume.Links {
+		pdf.SetX(textX)
+		doc.writeLink(link, 0)
+	}
+	if bottom := resumeMargin + resumeAvatarSize; pdf.GetY() < bottom {
+		pdf.SetY(bottom)
+	}
+
+	doc.writeAbout(resume.Profile.Bio, width)
+	doc.writeExperience(resume.Profile.Experiences, width)
+	doc.writeEducation(resume.Profile.Educations, width)
+	doc.writeTopSkills(resume.TopSkills, width)
+	if len(resume.OtherSkills) > 0 {
+		doc.heading("Other Skills", width)
+		doc.body()
+		pdf.MultiCell(width, 5, doc.tr(skillNames(resume.OtherSkills)), "", "L", false)
+	}
+	doc.writeProjects(resume.Projects, width)
+}
+
+// renderSidebar renders a two column resume with contact details and other skills in a
+// coloured sidebar on the left.
+func (r *GofpdfResumeRenderer) renderSidebar(doc *resumeWriter, resume *application.ResumeData) {
+	pdf := doc.pdf
+	pdf.SetHeaderFunc(func() {
+		_, pageHeight := pdf.GetPageSize()
+		pdf.SetFillColor(resumeSidebar[0], resumeSidebar[1], resumeSidebar[2])
+		pdf.Rect(0, 0, resumeSidebarWidth, pageHeight, "F")
+	})
+	mainX := resumeSidebarWidth + 10
+	pdf.SetMargins(mainX, resumeMargin, resumeMargin)
+	pdf.SetAutoPageBreak(true, resumeMargin)
+	pdf.AddPage()
+
+	// The sidebar is only filled on the first page and must not trigger page breaks.
+	pdf.SetAutoPageBreak(false, 0)
+	sideX := 8.0
+	sideWidth := resumeSidebarWidth - 2*sideX
+	y := resumeMargin
+	if avatar := r.registerAvatar(pdf, resume.Profile.ProfileImage); avatar != "" {
+		pdf.ImageOptions(avatar, (resumeSidebarWidth-resumeAvatarSize)/2, y, resumeAvatarSize, resumeAvatarSize, false, gofpdf.ImageOptions{}, 0, "")
+		y += resumeAvatarSize + 6
+	}
+	pdf.SetXY(sideX, y)
+	pdf.SetFont("Helvetica", "B", 14)
+	doc.color(resumeAccent)
+	pdf.MultiCell(sideWidth, 7, doc.tr(resume.Profile.Name), "", "C", false)
+	if resume.Profile.Location != "" {
+		pdf.SetX(sideX)
+		pdf.SetFont("Helvetica", "", 9)
+		doc.color(resumeMuted)
+		pdf.MultiCell(sideWidth, 5, doc.tr(resume.Profile.Location), "", "C", false)
+	}
+
+	if len(resume.Links) > 0 {
+		pdf.Ln(4)
+		pdf.SetX(sideX)
+		doc.sideHeading("Links", sideWidth)
+		for _, link := range resume.Links {
+			pdf.SetX(sideX)
+			pdf.SetFont("Helvetica", "B", 9)
+			doc.color([3]int{0, 0, 0})
+			pdf.CellFormat(sideWidth, 5, doc.tr(link.Label), "", 2, "L", false, 0, "")
+			pdf.SetFont("Helvetica", "", 8)
+			doc.color(resumeAccent)
+			pdf.MultiCell(sideWidth, 4, doc.tr(link.URL), "", "L", false)
+		}
+	}
+
+	if len(resume.OtherSkills) > 0 {
+		pdf.Ln(4)
+		pdf.SetX(sideX)
+		doc.sideHeading("Other Skills", sideWidth)
+		pdf.SetFont("Helvetica", "", 9)
+		doc.color([3]int{0, 0, 0})
+		for _, skill := range resume.OtherSkills {
+			pdf.SetX(sideX)
+			pdf.MultiCell(sideWidth, 5, doc.tr(skill.Name), "", "L", false)
+		}
+	}
+	pdf.SetAutoPageBreak(true, resumeMargin)
+
+	width := doc.contentWidth()
+	pdf.SetXY(mainX, resumeMargin)
+	if resume.Profile.ShortIntro != "" {
+		pdf.SetFont("Helvetica", "B", 15)
+		doc.color(resumeAccent)
+		pdf.MultiCell(width, 7, doc.tr(resume.Profile.ShortIntro), "", "L", false)
+	}
+	doc.writeAbout(resume.Profile.Bio, width)
+	doc.writeExperience(resume.Profile.Experiences, width)
+	doc.writeEducation(resume.Profile.Educations, width)
+	doc.writeTopSkills(resume.TopSkills, width)
+	doc.writeProjects(resume.Projects, width)
+}
+
+// registerAvatar registers the profile image with the document and returns its name,
+// or an empty string when the image is missing or in a format gofpdf cannot embed.
+func (r *GofpdfResumeRenderer) registerAvatar(pdf *gofpdf.Fpdf, image string) string {
+	if image == "" {
+		return ""
+	}
+	path := filepath.Join(r.MediaDir, filepath.FromSlash(image))
+	file, err := os.Open(path)
+	if err != nil {
+		return ""
+	}
+	defer file.Close()
+
+	imageType := strings.TrimPrefix(strings.ToLower(filepath.Ext(path)), ".")
+	pdf.RegisterImageOptionsReader(path, gofpdf.ImageOptions{ImageType: imageType}, file)
+	if !pdf.Ok() {
+		pdf.ClearError()
+		return ""
+	}
+	return path
+}
+
+func (doc *resumeWriter) contentWidth() float64 {
+	pageWidth, _ := doc.pdf.GetPageSize()
+	left, _, right, _ := doc.pdf.GetMargins()
+	return pageWidth - left - right
+}
+
+func (doc *resumeWriter) color(rgb [3]int) {
+	doc.pdf.SetTextColor(rgb[0], rgb[1], rgb[2])
+}
+
+func (doc *resumeWriter) body() {
+	doc.pdf.SetFont("Helvetica", "", 10)
+	doc.color([3]int{0, 0, 0})
+}
+
+func (doc *resumeWriter) heading(title string, width float64) {
+	doc.pdf.Ln(5)
+	doc.pdf.SetFont("Helvetica", "B", 13)
+	doc.color(resumeAccent)
+	doc.pdf.SetDrawColor(resumeAccent[0], resumeAccent[1], resumeAccent[2])
+	doc.pdf.CellFormat(width, 8, doc.tr(title), "B", 1, "L", false, 0, "")
+	doc.pdf.Ln(2)
+}
+
+func (doc *resumeWriter) sideHeading(title string, width float64) {
+	doc.pdf.SetFont("Helvetica", "B", 11)
+	doc.color(resumeAccent)
+	doc.pdf.CellFormat(width, 7, doc.tr(title), "", 2, "L", false, 0, "")
+}
+
+func (doc *resumeWriter) writeLink(link application.ResumeLink, width float64) {
+	doc.pdf.SetFont("Helvetica", "", 9)
+	doc.color(resumeAccent)
+	doc.pdf.CellFormat(width, 5, doc.tr(link.Label+": "+link.URL), "", 2, "L", false, 0, link.URL)
+}
+
+func (doc *resumeWriter) writeAbout(bio string, width float64) {
+	if strings.TrimSpace(bio) == "" {
+		return
+	}
+	doc.heading("About Me", width)
+	doc.body()
+	doc.pdf.MultiCell(width, 5, doc.tr(bio), "", "L", false)
+}
+
+func (doc *resumeWriter) writeExperience(experiences []domain.Experience, width float64) {
+	if len(experiences) == 0 {
+		return
+	}
+	doc.heading("Experience", width)
+	for _, experience := range experiences {
+		doc.pdf.SetFont("Helvetica", "B", 11)
+		doc.color([3]int{0, 0, 0})
+		doc.pdf.MultiCell(width, 6, doc.tr(experience.Title+" - "+experience.Company), "", "L", false)
+
+		doc.pdf.SetFont("Helvetica", "I", 9)
+		doc.color(resumeMuted)
+		meta := resumeDateRange(experience.StartDate, experience.EndDate)
+		if experience.Location != "" {
+			meta += " - " + experience.Location
+		}
+		doc.pdf.CellFormat(width, 5, doc.tr(meta), "", 1, "L", false, 0, "")
+
+		doc.body()
+		if experience.Description != "" {
+			doc.pdf.MultiCell(width, 5, doc.tr(experience.Description), "", "L", false)
+		}
+		if technologies := experience.TechnologyList(); len(technologies) > 0 {
+			doc.pdf.SetFont("Helvetica", "I", 9)
+			doc.pdf.MultiCell(width, 5, doc.tr(strings.Join(technologies, ", ")), "", "L", false)
+		}
+		doc.pdf.Ln(3)
+	}
+}
+
+func (doc *resumeWriter) writeEducation(educations []domain.Education, width float64) {
+	if len(educations) == 0 {
+		return
+	}
+	doc.heading("Education", width)
+	for _, education := range educations {
+		doc.pdf.SetFont("Helvetica", "B", 11)
+		doc.color([3]int{0, 0, 0})
+		doc.pdf.MultiCell(width, 6, doc.tr(education.School), "", "L", false)
+
+		doc.pdf.SetFont("Helvetica", "I", 9)
+		doc.color(resumeMuted)
+		var parts []string
+		for _, part := range []string{education.Degree, education.FieldOfStudy, resumeDateRange(education.StartDate, education.EndDate)} {
+			if part != "" {
+				parts = append(parts, part)
+			}
+		}
+		doc.pdf.CellFormat(width, 5, doc.tr(strings.Join(parts, " - ")), "", 1, "L", false, 0, "")
+		if education.Description != "" {
+			doc.body()
+			doc.pdf.MultiCell(width, 5, doc.tr(education.Description), "", "L", false)
+		}
+		doc.pdf.Ln(3)
+	}
+}
+
+func (doc *resumeWriter) writeTopSkills(skills []domain.Skill, width float64) {
+	if len(skills) == 0 {
+		return
+	}
+	doc.heading("Skills", width)
+	for _, skill := range skills {
+		doc.pdf.SetFont("Helvetica", "B", 11)
+		doc.color([3]int{0, 0, 0})
+		doc.pdf.CellFormat(width, 6, doc.tr(skill.Name), "", 1, "L", false, 0, "")
+		doc.body()
+		doc.pdf.MultiCell(width, 5, doc.tr(skill.Description), "", "L", false)
+		doc.pdf.Ln(2)
+	}
+}
+
+func (doc *resumeWriter) writeProjects(projects []domain.Project, width float64) {
+	if len(projects) == 0 {
+		return
+	}
+	doc.heading("Projects", width)
+	for _, project := range projects {
+		doc.pdf.SetFont("Helvetica", "B", 11)
+		doc.color([3]int{0, 0, 0})
+		doc.pdf.CellFormat(width, 6, doc.tr(project.Title), "", 1, "L", false, 0, "")
+
+		doc.pdf.SetFont("Helvetica", "I", 9)
+		doc.color(resumeMuted)
+		meta := fmt.Sprintf("%d%% positive feedback (%d votes)", project.VoteRatio, project.VoteTotal)
+		if len(project.Tags) > 0 {
+			var tags []string
+			for _, tag := range project.Tags {
+				tags = append(tags, tag.Name)
+			}
+			meta += " - " + strings.Join(tags, ", ")
+		}
+		doc.pdf.MultiCell(width, 5, doc.tr(meta), "", "L", false)
+
+		doc.body()
+		doc.pdf.MultiCell(width, 5, doc.tr(project.Description), "", "L", false)
+		if project.DemoLink != "" {
+			doc.writeLink(application.ResumeLink{Label: "Demo", URL: project.DemoLink}, width)
+			doc.pdf.Ln(0)
+		}
+		if project.SourceLink != "" {
+			doc.writeLink(application.ResumeLink{Label: "Source", URL: project.SourceLink}, width)
+			doc.pdf.Ln(0)
+		}
+		doc.pdf.Ln(3)
+	}
+}
+
+// resumeDateRange formats the period of an experience or education entry.
+func resumeDateRange(startDate time.Time, endDate *time.Time) string {
+	end := "Present"
+	if endDate != nil {
+		end = endDate.Format("Jan 2006")
+	}
+	return startDate.Format("Jan 2006") + " - " + end
+}
+
+// skillNames joins skill names into a comma separated list.
+func skillNames(skills []domain.Skill) string {
+	names := make([]string, 0, len(skills))
+	for _, skill := range skills {
+		names = append(names, skill.Name)
+	}
+	return strings.Join(names, ", ")
+}
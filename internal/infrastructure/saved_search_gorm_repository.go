@@ -0,0 +1,55 @@
+package infrastructure
+
+import (
+	"devsearch-go/internal/domain"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// GormSavedSearchRepository implements the application.SavedSearchRepository interface using GORM.
+type GormSavedSearchRepository struct {
+	DB *gorm.DB
+}
+
+// CreateSavedSearch creates a new saved search.
+func (r *GormSavedSearchRepository) CreateSavedSearch(search *domain.SavedSearch) error {
+	return r.DB.Create(search).Error
+}
+
+// FindSavedSearchByID retrieves a saved search by its ID.
+func (r *GormSavedSearchRepository) FindSavedSearchByID(id uuid.UUID) (*domain.SavedSearch, error) {
+	var search domain.SavedSearch
+	if err := r.DB.First(&search, "id = ?", id).Error; err != nil {
+		return nil, err
+	}
+	return &search, nil
+}
+
+// FindSavedSearchesByOwner retrieves the saved searches of a profile, newest first.
+func (r *GormSavedSearchRepository) FindSavedSearchesByOwner(ownerID uuid.UUID) ([]domain.SavedSearch, error) {
+	var searches []domain.SavedSearch
+	if err := r.DB.Where("owner_id = ?", ownerID).Order("created_at DESC").Find(&searches).Error; err != nil {
+		return nil, err
+	}
+	return searches, nil
+}
+
+// FindSavedSearchesWithAlerts retrieves every saved search whose owner opted into alerts.
+func (r *GormSavedSearchRepository) FindSavedSearchesWithAlerts() ([]domain.SavedSearch, error) {
+	var searches []domain.SavedSearch
+	if err := r.DB.Where("alerts_enabled = ?", true).Order("last_alerted_at ASC").Find(&searches).Error; err != nil {
+		return nil, err
+	}
+	return searches, nil
+}
+
+// UpdateSavedSearch updates an existing saved search.
+func (r *GormSavedSearchRepository) UpdateSavedSearch(search *domain.SavedSearch) error {
+	return r.DB.Save(search).Error
+}
+
+// DeleteSavedSearch deletes a saved search by its ID.
+func (r *GormSavedSearchRepository) DeleteSavedSearch(id uuid.UUID) error {
+	return r.DB.Delete(&domain.SavedSearch{}, "id = ?", id).Error
+}
@@ -0,0 +1,88 @@
+package infrastructure
+
+import (
+	"devsearch-go/internal/domain"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// GormShortlistRepository implements the application.ShortlistRepository interface using GORM.
+type GormShortlistRepository struct {
+	DB *gorm.DB
+}
+
+// FindShortlistsByOwner retrieves the shortlists of a profile with their candidates, newest first.
+func (r *GormShortlistRepository) FindShortlistsByOwner(ownerID uuid.UUID) ([]domain.Shortlist, error) {
+	var shortlists []domain.Shortlist
+	if err := r.DB.Preload("Candidates").Where("owner_id = ?", ownerID).Order("created_at DESC").Find(&shortlists).Error; err != nil {
+		return nil, err
+	}
+	return shortlists, nil
+}
+
+// FindShortlistByID retrieves a shortlist with its candidates' profiles, in the order they were added.
+func (r *GormShortlistRepository) FindShortlistByID(id uuid.UUID) (*domain.Shortlist, error) {
+	var shortlist domain.Shortlist
+	err := r.DB.Preload("Candidates", func(db *gorm.DB) *gorm.DB {
+		return db.Order("created_at ASC")
+	}).Preload("Candidates.Profile").First(&shortlist, "id = ?", id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &shortlist, nil
+}
+
+// CreateShortlist creates a new shortlist.
+func (r *GormShortlistRepository) CreateShortlist(shortlist *domain.Shortlist) error {
+	return r.DB.Create(shortlist).Error
+}
+
+// UpdateShortlistName renames a shortlist without touching its candidates.
+func (r *GormShortlistRepository) UpdateShortlistName(id uuid.UUID, name string) error {
+	return r.DB.Model(&domain.Shortlist{}).Where("id = ?", id).Update("name", name).Error
+}
+
+// DeleteShortlist deletes a shortlist and its candidates by the shortlist's ID.
+func (r *GormShortlistRepository) DeleteShortlist(id uuid.UUID) error {
+	return r.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Delete(&domain.ShortlistCandidate{}, "shortlist_id = ?", id).Error; err != nil {
+			return err
+		}
+		return tx.Delete(&domain.Shortlist{}, "id = ?", id).Error
+	})
+}
+
+// FindCandidateByID retrieves a shortlist candidate by its ID.
+func (r *GormShortlistRepository) FindCandidateByID(id uuid.UUID) (*domain.ShortlistCandidate, error) {
+	var candidate domain.ShortlistCandidate
+	if err := r.DB.First(&candidate, "id = ?", id).Error; err != nil {
+		return nil, err
+	}
+	return &candidate, nil
+}
+
+// FindCandidate retrieves the entry of a profile on a shortlist.
+func (r *GormShortlistRepository) FindCandidate(shortlistID, profileID uuid.UUID) (*domain.ShortlistCandidate, error) {
+	var candidate domain.ShortlistCandidate
+	if err := r.DB.Where("shortlist_id = ? AND profile_id = ?", shortlistID, profileID).First(&candidate).Error; err != nil {
+		return nil, err
+	}
+	return &candidate, nil
+}
+
+// CreateCandidate adds a profile to a shortlist.
+func (r *GormShortlistRepository) CreateCandidate(candidate *domain.ShortlistCandidate) error {
+	return r.DB.Create(candidate).Error
+}
+
+// UpdateCandidate saves the stage and note of a shortlist candidate.
+func (r *GormShortlistRepository) UpdateCandidate(candidate *domain.ShortlistCandidate) error {
+	return r.DB.Model(&domain.ShortlistCandidate{}).Where("id = ?", candidate.ID).
+		Updates(map[string]interface{}{"stage": candidate.Stage, "note": candidate.Note}).Error
+}
+
+// DeleteCandidate removes a candidate from its shortlist by the candidate's ID.
+func (r *GormShortlistRepository) DeleteCandidate(id uuid.UUID) error {
+	return r.DB.Delete(&domain.ShortlistCandidate{}, "id = ?", id).Error
+}
@@ -0,0 +1,60 @@
+package infrastructure
+
+import (
+	"devsearch-go/internal/domain"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// GormSkillCatalogRepository implements the application.SkillCatalogRepository interface using GORM.
+type GormSkillCatalogRepository struct {
+	DB *gorm.DB
+}
+
+// FindCatalogSkillBySlugOrAlias retrieves the catalog skill whose slug or one of whose aliases
+// equals the normalized key.
+func (r *GormSkillCatalogRepository) FindCatalogSkillBySlugOrAlias(key string) (*domain.CatalogSkill, error) {
+	var catalogSkill domain.CatalogSkill
+	err := r.DB.Preload("Aliases").
+		Where("slug = ? OR id IN (SELECT catalog_skill_id FROM skill_aliases WHERE alias = ?)", key, key).
+		First(&catalogSkill).Error
+	if err != nil {
+		return nil, err
+	}
+	return &catalogSkill, nil
+}
+
+// SearchCatalogSkills retrieves catalog skills whose name, slug or an alias starts with the query.
+func (r *GormSkillCatalogRepository) SearchCatalogSkills(query string, limit int) ([]domain.CatalogSkill, error) {
+	var catalogSkills []domain.CatalogSkill
+	key := domain.NormalizeSkillName(query) + "%"
+	err := r.DB.Preload("Aliases").
+		Where("name ILIKE ? OR slug LIKE ? OR id IN (SELECT catalog_skill_id FROM skill_aliases WHERE alias LIKE ?)", query+"%", key, key).
+		Order("name ASC").Limit(limit).Find(&catalogSkills).Error
+	if err != nil {
+		return nil, err
+	}
+	return catalogSkills, nil
+}
+
+// CreateCatalogSkill creates a new catalog skill with its aliases.
+func (r *GormSkillCatalogRepository) CreateCatalogSkill(catalogSkill *domain.CatalogSkill) error {
+	return r.DB.Create(catalogSkill).Error
+}
+
+// AddSkillAlias adds an alias to a catalog skill.
+func (r *GormSkillCatalogRepository) AddSkillAlias(alias *domain.SkillAlias) error {
+	return r.DB.Create(alias).Error
+}
+
+// FindUncatalogedSkills retrieves skills not linked to the catalog, ordered by ID after afterID.
+func (r *GormSkillCatalogRepository) FindUncatalogedSkills(afterID uuid.UUID, limit int) ([]domain.Skill, error) {
+	var skills []domain.Skill
+	err := r.DB.Where("catalog_skill_id IS NULL AND id > ?", afterID).
+		Order("id ASC").Limit(limit).Find(&skills).Error
+	if err != nil {
+		return nil, err
+	}
+	return skills, nil
+}
@@ -0,0 +1,30 @@
+package infrastructure
+
+import (
+	"devsearch-go/internal/domain"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// GormSlugRedirectRepository implements the application.SlugRedirectRepository interface using GORM.
+type GormSlugRedirectRepository struct {
+	DB *gorm.DB
+}
+
+// SaveSlugRedirect creates a redirect, or points an existing redirect for the same slug at the new target.
+func (r *GormSlugRedirectRepository) SaveSlugRedirect(redirect *domain.SlugRedirect) error {
+	return r.DB.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "kind"}, {Name: "slug"}},
+		DoUpdates: clause.AssignmentColumns([]string{"target_id", "updated_at"}),
+	}).Create(redirect).Error
+}
+
+// FindSlugRedirect retrieves the redirect registered for an old slug.
+func (r *GormSlugRedirectRepository) FindSlugRedirect(kind, slug string) (*domain.SlugRedirect, error) {
+	var redirect domain.SlugRedirect
+	if err := r.DB.Where("kind = ? AND slug = ?", kind, slug).First(&redirect).Error; err != nil {
+		return nil, err
+	}
+	return &redirect, nil
+}
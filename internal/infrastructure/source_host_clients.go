@@ -0,0 +1,291 @@
+package infrastructure
+
+import (
+	"devsearch-go/internal/application"
+
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const (
+	// sourceHostTimeout bounds each request to a code host.
+	sourceHostTimeout = 15 * time.Second
+	// maxSourceHostResponseSize limits how much of a code host response is read.
+	maxSourceHostResponseSize = 1 << 20
+	// sourceHostUserAgent identifies the sync job to code hosts; GitHub rejects requests without one.
+	sourceHostUserAgent = "devsearch-go"
+)
+
+var defaultSourceHostClient = &http.Client{Timeout: sourceHostTimeout}
+
+// GitHubSourceHost implements the application.SourceHost interface for GitHub and GitHub
+// Enterprise through the GitHub REST API. APIURL is "https://api.github.com" for github.com.
+type GitHubSourceHost struct {
+	Host   string
+	APIURL string
+	Token  string
+	Client *http.Client // defaultSourceHostClient when nil
+}
+
+// RepositoryPath returns "owner/name" for links to a repository on the host.
+func (h *GitHubSourceHost) RepositoryPath(link *url.URL) string {
+	return ownerRepositoryPath(h.Host, link)
+}
+
+// FetchRepository fetches a repository and the date of the last commit on its default branch.
+func (h *GitHubSourceHost) FetchRepository(repositoryPath string) (*application.SourceRepository, error) {
+	var repo struct {
+		StargazersCount int    `json:"stargazers_count"`
+		Archived        bool   `json:"archived"`
+		DefaultBranch   string `json:"default_branch"`
+		License         *struct {
+			SPDXID string `json:"spdx_id"`
+			Name   string `json:"name"`
+		} `json:"license"`
+	}
+	base := strings.TrimSuffix(h.APIURL, "/") + "/repos/" + escapePath(repositoryPath)
+	if _, err := h.get(base, &repo); err != nil {
+		return nil, err
+	}
+
+	result := &application.SourceRepository{Stars: repo.StargazersCount, Archived: repo.Archived}
+	if repo.License != nil {
+		result.License = repo.License.SPDXID
+		if result.License == "" || result.License == "NOASSERTION" {
+			result.License = repo.License.Name
+		}
+	}
+	if repo.DefaultBranch != "" {
+		var commits []struct {
+			Commit struct {
+				Committer struct {
+					Date time.Time `json:"date"`
+				} `json:"committer"`
+			} `json:"commit"`
+		}
+		// Empty repositories answer 409 Conflict
+		status, err := h.get(base+"/commits?per_page=1&sha="+url.QueryEscape(repo.DefaultBranch), &commits)
+		if err != nil && status != http.StatusConflict {
+			return nil, err
+		}
+		if len(commits) > 0 {
+			result.LastCommitAt = nonZeroTime(commits[0].Commit.Committer.Date)
+		}
+	}
+	return result, nil
+}
+
+// get fetches a GitHub API resource, authenticated when a token is configured.
+func (h *GitHubSourceHost) get(requestURL string, target interface{}) (int, error) {
+	header := http.Header{"Accept": {"application/vnd.github+json"}}
+	if h.Token != "" {
+		header.Set("Authorization", "Bearer "+h.Token)
+	}
+	return getSourceHostJSON(h.Client, requestURL, header, target)
+}
+
+// GitLabSourceHost implements the application.SourceHost interface for GitLab through its REST
+// API. APIURL is "https://gitlab.com/api/v4" for gitlab.com.
+type GitLabSourceHost struct {
+	Host   string
+	APIURL string
+	Token  string
+	Client *http.Client // defaultSourceHostClient when nil
+}
+
+// RepositoryPath returns the full path of the project, including any subgroups, for links to
+// a project on the host.
+func (h *GitLabSourceHost) RepositoryPath(link *url.URL) string {
+	if !sameHost(h.Host, link) {
+		return ""
+	}
+	// Pages of a project, such as its files or issues, live under "/-/"
+	projectPath, _, _ := strings.Cut(strings.Trim(link.Path, "/"), "/-/")
+	projectPath = strings.TrimSuffix(projectPath, ".git")
+	if strings.Count(projectPath, "/") < 1 || strings.Contains(projectPath, "//") {
+		return ""
+	}
+	return projectPath
+}
+
+// FetchRepository fetches a project and the date of the last commit on its default branch.
+func (h *GitLabSourceHost) FetchRepository(repositoryPath string) (*application.SourceRepository, error) {
+	var project struct {
+		StarCount     int    `json:"star_count"`
+		Archived      bool   `json:"archived"`
+		DefaultBranch string `json:"default_branch"`
+		License       *struct {
+			Key  string `json:"key"`
+			Name string `json:"name"`
+		} `json:"license"`
+	}
+	base := strings.TrimSuffix(h.APIURL, "/") + "/projects/" + url.PathEscape(repositoryPath)
+	if _, err := h.get(base+"?license=true", &project); err != nil {
+		return nil, err
+	}
+
+	result := &application.SourceRepository{Stars: project.StarCount, Archived: project.Archived}
+	if project.License != nil {
+		result.License = project.License.Name
+	}
+	// Empty projects have no default branch
+	if project.DefaultBranch != "" {
+		var commits []struct {
+			CommittedDate time.Time `json:"committed_date"`
+		}
+		if _, err := h.get(base+"/repository/commits?per_page=1&ref_name="+url.QueryEscape(project.DefaultBranch), &commits); err != nil {
+			return nil, err
+		}
+		if len(commits) > 0 {
+			result.LastCommitAt = nonZeroTime(commits[0].CommittedDate)
+		}
+	}
+	return result, nil
+}
+
+// get fetches a GitLab API resource, authenticated when a token is configured.
+func (h *GitLabSourceHost) get(requestURL string, target interface{}) (int, error) {
+	header := http.Header{"Accept": {"application/json"}}
+	if h.Token != "" {
+		header.Set("PRIVATE-TOKEN", h.Token)
+	}
+	return getSourceHostJSON(h.Client, requestURL, header, target)
+}
+
+// GiteaSourceHost implements the application.SourceHost interface for Gitea and Forgejo
+// through their REST API. APIURL is the instance address followed by "/api/v1".
+type GiteaSourceHost struct {
+	Host   string
+	APIURL string
+	Token  string
+	Client *http.Client // defaultSourceHostClient when nil
+}
+
+// RepositoryPath returns "owner/name" for links to a repository on the host.
+func (h *GiteaSourceHost) RepositoryPath(link *url.URL) string {
+	return ownerRepositoryPath(h.Host, link)
+}
+
+// FetchRepository fetches a repository and the date of the last commit on its default branch.
+func (h *GiteaSourceHost) FetchRepository(repositoryPath string) (*application.SourceRepository, error) {
+	var repo struct {
+		StarsCount    int      `json:"stars_count"`
+		Archived      bool     `json:"archived"`
+		Empty         bool     `json:"empty"`
+		DefaultBranch string   `json:"default_branch"`
+		Licenses      []string `json:"licenses"`
+	}
+	base := strings.TrimSuffix(h.APIURL, "/") + "/repos/" + escapePath(repositoryPath)
+	if _, err := h.get(base, &repo); err != nil {
+		return nil, err
+	}
+
+	result := &application.SourceRepository{Stars: repo.StarsCount, Archived: repo.Archived}
+	if len(repo.Licenses) > 0 {
+		result.License = strings.Join(repo.Licenses, ", ")
+	}
+	if !repo.Empty && repo.DefaultBranch != "" {
+		var commits []struct {
+			Commit struct {
+				Committer struct {
+					Date time.Time `json:"date"`
+				} `json:"committer"`
+			} `json:"commit"`
+		}
+		// Empty repositories answer 409 Conflict
+		status, err := h.get(base+"/commits?limit=1&stat=false&verification=false&files=false&sha="+url.QueryEscape(repo.DefaultBranch), &commits)
+		if err != nil && status != http.StatusConflict {
+			return nil, err
+		}
+		if len(commits) > 0 {
+			result.LastCommitAt = nonZeroTime(commits[0].Commit.Committer.Date)
+		}
+	}
+	return result, nil
+}
+
+// get fetches a Gitea API resource, authenticated when a token is configured.
+func (h *GiteaSourceHost) get(requestURL string, target interface{}) (int, error) {
+	header := http.Header{"Accept": {"application/json"}}
+	if h.Token != "" {
+		header.Set("Authorization", "token "+h.Token)
+	}
+	return getSourceHostJSON(h.Client, requestURL, header, target)
+}
+
+// getSourceHostJSON fetches a code host API resource into target. It returns the status code
+// with the error, maps 404 to application.ErrSourceRepositoryNotFound, and 429 or a 403 with
+// an exhausted rate limit to application.ErrSourceHostRateLimited.
+func getSourceHostJSON(client *http.Client, requestURL string, header http.Header, target interface{}) (int, error) {
+	if client == nil {
+		client = defaultSourceHostClient
+	}
+	req, err := http.NewRequest(http.MethodGet, requestURL, nil)
+	if err != nil {
+		return 0, err
+	}
+	req.Header = header
+	req.Header.Set("User-Agent", sourceHostUserAgent)
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return 0, fmt.Errorf("failed to reach code host: %w", err)
+	}
+	defer resp.Body.Close()
+
+	switch {
+	case resp.StatusCode == http.StatusOK:
+	case resp.StatusCode == http.StatusNotFound:
+		return resp.StatusCode, application.ErrSourceRepositoryNotFound
+	case resp.StatusCode == http.StatusTooManyRequests,
+		resp.StatusCode == http.StatusForbidden && resp.Header.Get("X-RateLimit-Remaining") == "0":
+		return resp.StatusCode, application.ErrSourceHostRateLimited
+	default:
+		return resp.StatusCode, fmt.Errorf("code host answered %s", resp.Status)
+	}
+	if err := json.NewDecoder(io.LimitReader(resp.Body, maxSourceHostResponseSize)).Decode(target); err != nil {
+		return resp.StatusCode, fmt.Errorf("failed to decode code host response: %w", err)
+	}
+	return resp.StatusCode, nil
+}
+
+// ownerRepositoryPath returns "owner/name" for links to a repository on a host that names
+// repositories that way, or "" for other links.
+func ownerRepositoryPath(host string, link *url.URL) string {
+	if !sameHost(host, link) {
+		return ""
+	}
+	segments := strings.Split(strings.Trim(link.Path, "/"), "/")
+	if len(segments) < 2 || segments[0] == "" || segments[1] == "" {
+		return ""
+	}
+	return segments[0] + "/" + strings.TrimSuffix(segments[1], ".git")
+}
+
+// sameHost reports whether a link points to host, with or without "www.".
+func sameHost(host string, link *url.URL) bool {
+	linkHost := strings.TrimPrefix(strings.ToLower(link.Host), "www.")
+	return host != "" && linkHost == strings.TrimPrefix(strings.ToLower(host), "www.")
+}
+
+// escapePath escapes each segment of a slash-separated path.
+func escapePath(p string) string {
+	segments := strings.Split(p, "/")
+	for i, segment := range segments {
+		segments[i] = url.PathEscape(segment)
+	}
+	return strings.Join(segments, "/")
+}
+
+// nonZeroTime returns a pointer to t, or nil when t is the zero time.
+func nonZeroTime(t time.Time) *time.Time {
+	if t.IsZero() {
+		return nil
+	}
+	return &t
+}
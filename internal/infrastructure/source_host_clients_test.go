@@ -0,0 +1,245 @@
+package infrastructure
+
+import (
+	"devsearch-go/internal/application"
+
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+)
+
+// fakeResponse is what the fake code host answers for a path.
+type fakeResponse struct {
+	status int
+	header map[string]string
+	body   string
+}
+
+// newFakeCodeHost starts a code host API answering the escaped paths in routes, and 404 for
+// any other path. Requests without the expected authorization header fail the test.
+func newFakeCodeHost(t *testing.T, authHeader, authValue string, routes map[string]fakeResponse) *httptest.Server {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if got := r.Header.Get(authHeader); got != authValue {
+			t.Errorf("%s header of %s = %q, want %q", authHeader, r.URL, got, authValue)
+		}
+		if r.Header.Get("User-Agent") == "" {
+			t.Errorf("request to %s has no User-Agent", r.URL)
+		}
+		response, ok := routes[r.URL.EscapedPath()]
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		for name, value := range response.header {
+			w.Header().Set(name, value)
+		}
+		w.WriteHeader(response.status)
+		w.Write([]byte(response.body))
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestSourceHostFetchRepository(t *testing.T) {
+	lastCommit := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	github := func(apiURL string, client *http.Client) application.SourceHost {
+		return &GitHubSourceHost{Host: "github.com", APIURL: apiURL, Token: "gh-token", Client: client}
+	}
+	gitlab := func(apiURL string, client *http.Client) application.SourceHost {
+		return &GitLabSourceHost{Host: "gitlab.com", APIURL: apiURL, Token: "gl-token", Client: client}
+	}
+	gitea := func(apiURL string, client *http.Client) application.SourceHost {
+		return &GiteaSourceHost{Host: "codeberg.org", APIURL: apiURL, Token: "gt-token", Client: client}
+	}
+	const (
+		githubCommits = `[{"commit":{"committer":{"date":"2024-05-01T12:00:00Z"}}}]`
+		gitlabProject = "/projects/group%2Fsub%2Fhello"
+	)
+
+	tests := []struct {
+		name                  string
+		host                  func(apiURL string, client *http.Client) application.SourceHost
+		authHeader, authValue string
+		repositoryPath        string
+		routes                map[string]fakeResponse
+		want                  *application.SourceRepository
+		wantErr               error // Checked with errors.Is
+		wantOtherErr          bool  // An error that is neither sentinel
+	}{
+		{
+			name: "github repository", host: github, authHeader: "Authorization", authValue: "Bearer gh-token", repositoryPath: "octo/hello",
+			routes: map[string]fakeResponse{
+				"/repos/octo/hello":         {status: 200, body: `{"stargazers_count":42,"archived":true,"default_branch":"main","license":{"spdx_id":"MIT","name":"MIT License"}}`},
+				"/repos/octo/hello/commits": {status: 200, body: githubCommits},
+			},
+			want: &application.SourceRepository{Stars: 42, LastCommitAt: &lastCommit, License: "MIT", Archived: true},
+		},
+		{
+			name: "github license without SPDX identifier", host: github, authHeader: "Authorization", authValue: "Bearer gh-token", repositoryPath: "octo/hello",
+			routes: map[string]fakeResponse{
+				"/repos/octo/hello":         {status: 200, body: `{"stargazers_count":1,"default_branch":"main","license":{"spdx_id":"NOASSERTION","name":"Other"}}`},
+				"/repos/octo/hello/commits": {status: 200, body: githubCommits},
+			},
+			want: &application.SourceRepository{Stars: 1, LastCommitAt: &lastCommit, License: "Other"},
+		},
+		{
+			name: "github empty repository", host: github, authHeader: "Authorization", authValue: "Bearer gh-token", repositoryPath: "octo/hello",
+			routes: map[string]fakeResponse{
+				"/repos/octo/hello":         {status: 200, body: `{"stargazers_count":0,"default_branch":"main","license":null}`},
+				"/repos/octo/hello/commits": {status: 409, body: `{"message":"Git Repository is empty."}`},
+			},
+			want: &application.SourceRepository{},
+		},
+		{
+			name: "github missing repository", host: github, authHeader: "Authorization", authValue: "Bearer gh-token", repositoryPath: "octo/hello",
+			wantErr: application.ErrSourceRepositoryNotFound,
+		},
+		{
+			name: "github exhausted rate limit", host: github, authHeader: "Authorization", authValue: "Bearer gh-token", repositoryPath: "octo/hello",
+			routes: map[string]fakeResponse{
+				"/repos/octo/hello": {status: 403, header: map[string]string{"X-RateLimit-Remaining": "0"}, body: `{"message":"API rate limit exceeded"}`},
+			},
+			wantErr: application.ErrSourceHostRateLimited,
+		},
+		{
+			name: "github forbidden without rate limit", host: github, authHeader: "Authorization", authValue: "Bearer gh-token", repositoryPath: "octo/hello",
+			routes: map[string]fakeResponse{
+				"/repos/octo/hello": {status: 403, header: map[string]string{"X-RateLimit-Remaining": "4999"}, body: `{"message":"Forbidden"}`},
+			},
+			wantOtherErr: true,
+		},
+		{
+			name: "gitlab project in a subgroup", host: gitlab, authHeader: "PRIVATE-TOKEN", authValue: "gl-token", repositoryPath: "group/sub/hello",
+			routes: map[string]fakeResponse{
+				gitlabProject:                         {status: 200, body: `{"star_count":7,"archived":false,"default_branch":"main","license":{"key":"apache-2.0","name":"Apache License 2.0"}}`},
+				gitlabProject + "/repository/commits": {status: 200, body: `[{"committed_date":"2024-05-01T12:00:00Z"}]`},
+			},
+			want: &application.SourceRepository{Stars: 7, LastCommitAt: &lastCommit, License: "Apache License 2.0"},
+		},
+		{
+			name: "gitlab empty project", host: gitlab, authHeader: "PRIVATE-TOKEN", authValue: "gl-token", repositoryPath: "group/sub/hello",
+			routes: map[string]fakeResponse{
+				gitlabProject: {status: 200, body: `{"star_count":2,"archived":true,"default_branch":null,"license":null}`},
+			},
+			want: &application.SourceRepository{Stars: 2, Archived: true},
+		},
+		{
+			name: "gitlab missing project", host: gitlab, authHeader: "PRIVATE-TOKEN", authValue: "gl-token", repositoryPath: "group/sub/hello",
+			wantErr: application.ErrSourceRepositoryNotFound,
+		},
+		{
+			name: "gitlab rate limit", host: gitlab, authHeader: "PRIVATE-TOKEN", authValue: "gl-token", repositoryPath: "group/sub/hello",
+			routes: map[string]fakeResponse{
+				gitlabProject: {status: 429, header: map[string]string{"Retry-After": "60"}},
+			},
+			wantErr: application.ErrSourceHostRateLimited,
+		},
+		{
+			name: "gitea repository", host: gitea, authHeader: "Authorization", authValue: "token gt-token", repositoryPath: "octo/hello",
+			routes: map[string]fakeResponse{
+				"/repos/octo/hello":         {status: 200, body: `{"stars_count":3,"archived":true,"empty":false,"default_branch":"main","licenses":["MIT","Apache-2.0"]}`},
+				"/repos/octo/hello/commits": {status: 200, body: githubCommits},
+			},
+			want: &application.SourceRepository{Stars: 3, LastCommitAt: &lastCommit, License: "MIT, Apache-2.0", Archived: true},
+		},
+		{
+			name: "gitea empty repository", host: gitea, authHeader: "Authorization", authValue: "token gt-token", repositoryPath: "octo/hello",
+			routes: map[string]fakeResponse{
+				"/repos/octo/hello": {status: 200, body: `{"stars_count":0,"empty":true,"default_branch":"main","licenses":[]}`},
+			},
+			want: &application.SourceRepository{},
+		},
+		{
+			name: "gitea missing repository", host: gitea, authHeader: "Authorization", authValue: "token gt-token", repositoryPath: "octo/hello",
+			wantErr: application.ErrSourceRepositoryNotFound,
+		},
+		{
+			name: "gitea rate limit", host: gitea, authHeader: "Authorization", authValue: "token gt-token", repositoryPath: "octo/hello",
+			routes: map[string]fakeResponse{
+				"/repos/octo/hello": {status: 429},
+			},
+			wantErr: application.ErrSourceHostRateLimited,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := newFakeCodeHost(t, tt.authHeader, tt.authValue, tt.routes)
+			got, err := tt.host(server.URL, server.Client()).FetchRepository(tt.repositoryPath)
+
+			switch {
+			case tt.wantErr != nil:
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("FetchRepository() error = %v, want %v", err, tt.wantErr)
+				}
+				return
+			case tt.wantOtherErr:
+				if err == nil || errors.Is(err, application.ErrSourceRepositoryNotFound) || errors.Is(err, application.ErrSourceHostRateLimited) {
+					t.Fatalf("FetchRepository() error = %v, want another error", err)
+				}
+				return
+			case err != nil:
+				t.Fatalf("FetchRepository() error = %v", err)
+			}
+
+			if got.Stars != tt.want.Stars || got.License != tt.want.License || got.Archived != tt.want.Archived {
+				t.Errorf("FetchRepository() = stars %d, license %q, archived %v; want stars %d, license %q, archived %v",
+					got.Stars, got.License, got.Archived, tt.want.Stars, tt.want.License, tt.want.Archived)
+			}
+			switch {
+			case tt.want.LastCommitAt == nil && got.LastCommitAt != nil:
+				t.Errorf("FetchRepository() last commit = %v, want none", *got.LastCommitAt)
+			case tt.want.LastCommitAt != nil && (got.LastCommitAt == nil || !got.LastCommitAt.Equal(*tt.want.LastCommitAt)):
+				t.Errorf("FetchRepository() last commit = %v, want %v", got.LastCommitAt, *tt.want.LastCommitAt)
+			}
+		})
+	}
+}
+
+func TestSourceHostRepositoryPath(t *testing.T) {
+	github := &GitHubSourceHost{Host: "github.com"}
+	gitlab := &GitLabSourceHost{Host: "gitlab.com"}
+	gitea := &GiteaSourceHost{Host: "codeberg.org"}
+
+	tests := []struct {
+		name string
+		host application.SourceHost
+		link string
+		want string
+	}{
+		{"github repository", github, "https://github.com/octo/hello", "octo/hello"},
+		{"github with www and trailing slash", github, "https://www.github.com/octo/hello/", "octo/hello"},
+		{"github clone address", github, "https://github.com/octo/hello.git", "octo/hello"},
+		{"github page inside repository", github, "https://github.com/octo/hello/tree/main/cmd", "octo/hello"},
+		{"github host in capitals", github, "https://GitHub.com/octo/hello", "octo/hello"},
+		{"github user page", github, "https://github.com/octo", ""},
+		{"github link to another host", github, "https://gitlab.com/octo/hello", ""},
+		{"gitlab project", gitlab, "https://gitlab.com/group/hello", "group/hello"},
+		{"gitlab project in subgroups", gitlab, "https://gitlab.com/group/sub/hello", "group/sub/hello"},
+		{"gitlab page inside project", gitlab, "https://gitlab.com/group/sub/hello/-/tree/main", "group/sub/hello"},
+		{"gitlab clone address", gitlab, "https://gitlab.com/group/hello.git", "group/hello"},
+		{"gitlab group page", gitlab, "https://gitlab.com/group", ""},
+		{"gitlab empty segment", gitlab, "https://gitlab.com/group//hello", ""},
+		{"gitlab link to another host", gitlab, "https://github.com/group/hello", ""},
+		{"gitea repository", gitea, "https://codeberg.org/octo/hello", "octo/hello"},
+		{"gitea page inside repository", gitea, "https://codeberg.org/octo/hello/src/branch/main", "octo/hello"},
+		{"gitea user page", gitea, "https://codeberg.org/octo", ""},
+		{"gitea link to another host", gitea, "https://github.com/octo/hello", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			link, err := url.Parse(tt.link)
+			if err != nil {
+				t.Fatalf("url.Parse(%q) error = %v", tt.link, err)
+			}
+			if got := tt.host.RepositoryPath(link); got != tt.want {
+				t.Errorf("RepositoryPath(%q) = %q, want %q", tt.link, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,35 @@
+package infrastructure
+
+import (
+	"devsearch-go/internal/domain"
+
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// GormSourceStatsRepository implements the application.SourceStatsRepository interface using GORM.
+type GormSourceStatsRepository struct {
+	DB *gorm.DB
+}
+
+// FindProjectsDueForSourceSync retrieves the projects whose source link statistics are missing,
+// stale or fetched for another link, least recently checked first.
+func (r *GormSourceStatsRepository) FindProjectsDueForSourceSync(checkedBefore time.Time, limit int) ([]domain.Project, error) {
+	var projects []domain.Project
+	err := r.DB.Preload("SourceStats").
+		Joins("LEFT JOIN source_repo_stats ON source_repo_stats.project_id = projects.id").
+		Where("projects.source_link <> ''").
+		Where("source_repo_stats.project_id IS NULL OR source_repo_stats.source_link <> projects.source_link OR source_repo_stats.checked_at < ?", checkedBefore).
+		Order("source_repo_stats.checked_at ASC NULLS FIRST").Limit(limit).Find(&projects).Error
+	return projects, err
+}
+
+// SaveSourceRepoStats creates the statistics of a project's source link or replaces the ones stored before.
+func (r *GormSourceStatsRepository) SaveSourceRepoStats(stats *domain.SourceRepoStats) error {
+	return r.DB.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "project_id"}},
+		UpdateAll: true,
+	}).Create(stats).Error
+}
@@ -0,0 +1,222 @@
+package infrastructure
+
+import (
+	"devsearch-go/internal/domain"
+
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// GormTrashRepository implements the application.TrashRepository interface using GORM. Trashed
+// rows have deleted_at set, so they are only reached through Unscoped queries.
+type GormTrashRepository struct {
+	DB *gorm.DB
+}
+
+// DeleteProfile moves a profile to the trash together with its skills and its owner's projects,
+// all with the same deletion time so that RestoreProfile can tell them from items deleted before.
+func (r *GormTrashRepository) DeleteProfile(profile *domain.Profile) error {
+	now := time.Now()
+	return r.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&domain.Project{}).Where("owner_id = ?", profile.UserID).Update("deleted_at", now).Error; err != nil {
+			return err
+		}
+		if err := tx.Model(&domain.Skill{}).Where("owner_id = ?", profile.ID).Update("deleted_at", now).Error; err != nil {
+			return err
+		}
+		return tx.Model(&domain.Profile{}).Where("id = ?", profile.ID).Update("deleted_at", now).Error
+	})
+}
+
+// FindDeletedProjects retrieves the trashed projects owned by a user.
+func (r *GormTrashRepository) FindDeletedProjects(ownerUserID uuid.UUID) ([]domain.Project, error) {
+	var projects []domain.Project
+	err := r.DB.Unscoped().Where("owner_id = ? AND deleted_at IS NOT NULL", ownerUserID).Order("deleted_at DESC").Find(&projects).Error
+	return projects, err
+}
+
+// FindDeletedSkills retrieves the trashed skills of a profile.
+func (r *GormTrashRepository) FindDeletedSkills(ownerProfileID uuid.UUID) ([]domain.Skill, error) {
+	var skills []domain.Skill
+	err := r.DB.Unscoped().Where("owner_id = ? AND deleted_at IS NOT NULL", ownerProfileID).Order("deleted_at DESC").Find(&skills).Error
+	return skills, err
+}
+
+// FindDeletedProjectByID retrieves a trashed project by its ID, with its collaborators.
+func (r *GormTrashRepository) FindDeletedProjectByID(id uuid.UUID) (*domain.Project, error) {
+	var project domain.Project
+	if err := r.DB.Unscoped().Preload("Collaborators.Profile").Where("deleted_at IS NOT NULL").First(&project, "id = ?", id).Error; err != nil {
+		return nil, err
+	}
+	return &project, nil
+}
+
+// FindDeletedSkillByID retrieves a trashed skill by its ID.
+func (r *GormTrashRepository) FindDeletedSkillByID(id uuid.UUID) (*domain.Skill, error) {
+	var skill domain.Skill
+	if err := r.DB.Unscoped().Where("deleted_at IS NOT NULL").First(&skill, "id = ?", id).Error; err != nil {
+		return nil, err
+	}
+	return &skill, nil
+}
+
+// FindDeletedProfileByUserID retrieves the trashed profile of a user.
+func (r *GormTrashRepository) FindDeletedProfileByUserID(userID uuid.UUID) (*domain.Profile, error) {
+	var profile domain.Profile
+	if err := r.DB.Unscoped().Where("user_id = ? AND deleted_at IS NOT NULL", userID).First(&profile).Error; err != nil {
+		return nil, err
+	}
+	return &profile, nil
+}
+
+// RestoreProject takes a project out of the trash under the given slug.
+func (r *GormTrashRepository) RestoreProject(id uuid.UUID, slug string) error {
+	return r.DB.Unscoped().Model(&domain.Project{}).Where("id = ?", id).
+		Updates(map[string]interface{}{"deleted_at": nil, "slug": slug}).Error
+}
+
+// RestoreSkill takes a skill out of the trash.
+func (r *GormTrashRepository) RestoreSkill(id uuid.UUID) error {
+	return r.DB.Unscoped().Model(&domain.Skill{}).Where("id = ?", id).Update("deleted_at", nil).Error
+}
+
+// RestoreProfile takes a profile out of the trash with the projects and skills deleted together
+// with it. Items the user deleted on their own before stay in the trash.
+func (r *GormTrashRepository) RestoreProfile(profile *domain.Profile) error {
+	deletedAt := profile.DeletedAt.Time
+	return r.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Unscoped().Model(&domain.Project{}).Where("owner_id = ? AND deleted_at = ?", profile.UserID, deletedAt).Update("deleted_at", nil).Error; err != nil {
+			return err
+		}
+		if err := tx.Unscoped().Model(&domain.Skill{}).Where("owner_id = ? AND deleted_at = ?", profile.ID, deletedAt).Update("deleted_at", nil).Error; err != nil {
+			return err
+		}
+		return tx.Unscoped().Model(&domain.Profile{}).Where("id = ?", profile.ID).Update("deleted_at", nil).Error
+	})
+}
+
+// FindExpiredProjects retrieves up to limit projects trashed before the given time.
+func (r *GormTrashRepository) FindExpiredProjects(deletedBefore time.Time, limit int) ([]domain.Project, error) {
+	var projects []domain.Project
+	err := r.DB.Unscoped().Where("deleted_at < ?", deletedBefore).Order("deleted_at ASC").Limit(limit).Find(&projects).Error
+	return projects, err
+}
+
+// FindExpiredSkills retrieves up to limit skills trashed before the given time.
+func (r *GormTrashRepository) FindExpiredSkills(deletedBefore time.Time, limit int) ([]domain.Skill, error) {
+	var skills []domain.Skill
+	err := r.DB.Unscoped().Where("deleted_at < ?", deletedBefore).Order("deleted_at ASC").Limit(limit).Find(&skills).Error
+	return skills, err
+}
+
+// FindExpiredProfiles retrieves up to limit profiles trashed before the given time.
+func (r *GormTrashRepository) FindExpiredProfiles(deletedBefore time.Time, limit int) ([]domain.Profile, error) {
+	var profiles []domain.Profile
+	err := r.DB.Unscoped().Where("deleted_at < ?", deletedBefore).Order("deleted_at ASC").Limit(limit).Find(&profiles).Error
+	return profiles, err
+}
+
+// PurgeProject permanently deletes a project with its revisions, tag links, reviews,
+// recommendations, old slugs and page view statistics. Collaborators go with it through their
+// foreign key. It returns the featured images the revisions referred to.
+func (r *GormTrashRepository) PurgeProject(project *domain.Project) (revisionImages []string, err error) {
+	err = r.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&domain.ProjectRevision{}).Where("project_id = ?", project.ID).
+			Distinct().Pluck("featured_image", &revisionImages).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("project_id = ?", project.ID).Delete(&domain.ProjectRevision{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Unscoped().Model(project).Association("Tags").Clear(); err != nil {
+			return err
+		}
+		if err := tx.Where("project_id = ?", project.ID).Delete(&domain.Review{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("project_id = ?", project.ID).Delete(&domain.RelatedProject{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("project_id = ?", project.ID).Delete(&domain.SourceRepoStats{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("kind = ? AND target_id = ?", domain.SlugKindProject, project.ID).Delete(&domain.SlugRedirect{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("subject_id = ?", project.ID).Delete(&domain.ActivityEvent{}).Error; err != nil {
+			return err
+		}
+		if err := purgeViews(tx, domain.ViewKindProject, project.ID); err != nil {
+			return err
+		}
+		if err := purgeLinkHealth(tx, domain.LinkKindProject, project.ID); err != nil {
+			return err
+		}
+		return tx.Unscoped().Delete(&domain.Project{}, "id = ?", project.ID).Error
+	})
+	return revisionImages, err
+}
+
+// PurgeSkill permanently deletes a skill. Its endorsements go with it through their foreign key.
+func (r *GormTrashRepository) PurgeSkill(id uuid.UUID) error {
+	return r.DB.Unscoped().Delete(&domain.Skill{}, "id = ?", id).Error
+}
+
+// PurgeProfile permanently deletes a profile and its user, with their reviews, messages, career
+// entries, recommendations, old usernames and page view statistics. Follows, endorsements,
+// activity, shortlists, saved searches, job postings, collaborations and reports go with them
+// through their foreign keys. It returns the projects the user had reviewed, whose votes
+// need recounting.
+func (r *GormTrashRepository) PurgeProfile(profile *domain.Profile) (reviewedProjectIDs []uuid.UUID, err error) {
+	err = r.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&domain.Review{}).Where("owner_id = ?", profile.UserID).Distinct().Pluck("project_id", &reviewedProjectIDs).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("owner_id = ?", profile.UserID).Delete(&domain.Review{}).Error; err != nil {
+			return err
+		}
+		ids := []uuid.UUID{profile.ID, profile.UserID}
+		if err := tx.Where("sender_id IN ? OR recipient_id IN ?", ids, ids).Delete(&domain.Message{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("owner_id = ?", profile.ID).Delete(&domain.Experience{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("owner_id = ?", profile.ID).Delete(&domain.Education{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("profile_id = ?", profile.ID).Delete(&domain.SimilarProfile{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("kind = ? AND target_id = ?", domain.SlugKindProfile, profile.ID).Delete(&domain.SlugRedirect{}).Error; err != nil {
+			return err
+		}
+		if err := purgeViews(tx, domain.ViewKindProfile, profile.ID); err != nil {
+			return err
+		}
+		if err := purgeLinkHealth(tx, domain.LinkKindProfile, profile.ID); err != nil {
+			return err
+		}
+		if err := tx.Model(&domain.ReportCase{}).Where("resolved_by_id = ?", profile.UserID).Update("resolved_by_id", nil).Error; err != nil {
+			return err
+		}
+		if err := tx.Unscoped().Delete(&domain.Profile{}, "id = ?", profile.ID).Error; err != nil {
+			return err
+		}
+		return tx.Delete(&domain.User{}, "id = ?", profile.UserID).Error
+	})
+	return reviewedProjectIDs, err
+}
+
+// purgeViews deletes the raw page views and view statistics of a profile or project page.
+func purgeViews(tx *gorm.DB, kind string, targetID uuid.UUID) error {
+	if err := tx.Where("kind = ? AND target_id = ?", kind, targetID).Delete(&domain.PageView{}).Error; err != nil {
+		return err
+	}
+	if err := tx.Where("kind = ? AND target_id = ?", kind, targetID).Delete(&domain.ViewStat{}).Error; err != nil {
+		return err
+	}
+	return tx.Where("kind = ? AND target_id = ?", kind, targetID).Delete(&domain.ReferrerStat{}).Error
+}
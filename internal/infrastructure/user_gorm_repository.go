@@ -1,6 +1,7 @@
 package infrastructure
 
 import (
+	"devsearch-go/internal/application"
 	"devsearch-go/internal/domain"
 
 	"github.com/google/uuid"
@@ -67,7 +68,13 @@ func (r *GormProfileRepository) CreateProfile(profile *domain.Profile) error {
 // FindProfileByID retrieves a profile by its ID.
 func (r *GormProfileRepository) FindProfileByID(id uuid.UUID) (*domain.Profile, error) {
 	var profile domain.Profile
-	if err := r.DB.Preload("Skills").Preload("Projects.Tags").First(&profile, "id = ?", id).Error; err != nil {
+	query := r.DB.Preload("Skills", func(db *gorm.DB) *gorm.DB {
+		return db.Order("(SELECT COUNT(*) FROM endorsements WHERE endorsements.skill_id = skills.id) DESC, created_at ASC")
+	}).Preload("Skills.Endorsements", "endorser_id IN (SELECT id FROM profiles WHERE deleted_at IS NULL)", func(db *gorm.DB) *gorm.DB { return db.Order("created_at ASC") }).
+		Preload("Skills.Endorsements.Endorser").Preload("Projects.Tags").Preload("Projects.SourceStats").
+		Preload("Experiences", func(db *gorm.DB) *gorm.DB { return db.Order("start_date DESC") }).
+		Preload("Educations", func(db *gorm.DB) *gorm.DB { return db.Order("start_date DESC") })
+	if err := query.First(&profile, "id = ?", id).Error; err != nil {
 		return nil, err
 	}
 	return &profile, nil
@@ -82,20 +89,62 @@ func (r *GormProfileRepository) FindProfileByUserID(userID uuid.UUID) (*domain.P
 	return &profile, nil
 }
 
-// FindAllProfiles retrieves all profiles with optional search and pagination.
-func (r *GormProfileRepository) FindAllProfiles(searchQuery string, page, limit int) ([]domain.Profile, int64, error) {
+// FindProfileByUsername retrieves a profile by its username, ignoring case.
+func (r *GormProfileRepository) FindProfileByUsername(username string) (*domain.Profile, error) {
+	var profile domain.Profile
+	if err := r.DB.Where("LOWER(username) = LOWER(?)", username).First(&profile).Error; err != nil {
+		return nil, err
+	}
+	return &profile, nil
+}
+
+// FindAllProfiles retrieves all profiles matching the filter, with sorting and pagination.
+func (r *GormProfileRepository) FindAllProfiles(filter application.ProfileFilter, page, limit int) ([]domain.Profile, int64, error) {
 	var profiles []domain.Profile
-	query := r.DB.Preload("Skills")
+	// Unlisted, private and hidden profiles are never returned by search.
+	query := r.DB.Preload("Skills").Where("privacy_mode = ? AND hidden_at IS NULL", domain.ProfileModePublic)
+
+	if searchQuery := filter.Query; searchQuery != "" {
+		like := "%" + searchQuery + "%"
+		// Skill matches go through the catalog so that aliases ("golang") find canonical skills ("Go").
+		key := domain.NormalizeSkillName(searchQuery)
+		query = query.Where(r.DB.Where("name ILIKE ? OR short_intro ILIKE ? OR bio ILIKE ?", like, like, like).
+			Or("id IN (SELECT owner_id FROM skills WHERE deleted_at IS NULL AND (name ILIKE ? OR catalog_skill_id IN (SELECT id FROM catalog_skills WHERE slug = ? UNION SELECT catalog_skill_id FROM skill_aliases WHERE alias = ?)))", like, key, key).
+			Or("privacy_experiences = ? AND id IN (SELECT owner_id FROM experiences WHERE company ILIKE ? OR title ILIKE ? OR technologies ILIKE ?)", domain.VisibilityPublic, like, like, like).
+			Or("privacy_educations = ? AND id IN (SELECT owner_id FROM educations WHERE school ILIKE ? OR degree ILIKE ? OR field_of_study ILIKE ?)", domain.VisibilityPublic, like, like, like))
+	}
 
-	if searchQuery != "" {
-		query = query.Where("name ILIKE ? OR short_intro ILIKE ? OR bio ILIKE ?", "%"+searchQuery+"%", "%"+searchQuery+"%", "%"+searchQuery+"%")
+	if filter.Availability != "" {
+		query = query.Where("availability = ?", filter.Availability)
+	}
+	if filter.WorkArrangement != "" {
+		query = query.Where("work_arrangement = ?", filter.WorkArrangement)
+	}
+	if filter.Role != "" {
+		query = query.Where("preferred_roles ILIKE ?", "%"+filter.Role+"%")
+	}
+	if filter.TimeZone != "" {
+		query = query.Where("time_zone = ?", filter.TimeZone)
+	}
+	if filter.MaxSalary > 0 {
+		// Hidden salary expectations must not be discoverable by probing the filter.
+		query = query.Where("salary_visibility = ? AND salary_min > 0 AND salary_min <= ?", domain.VisibilityPublic, filter.MaxSalary)
+	}
+	if filter.SalaryCurrency != "" {
+		query = query.Where("salary_visibility = ? AND salary_currency = ?", domain.VisibilityPublic, filter.SalaryCurrency)
+	}
+	if !filter.UpdatedSince.IsZero() {
+		query = query.Where("profiles.updated_at > ?", filter.UpdatedSince)
 	}
 
 	var totalProfiles int64
 	query.Model(&domain.Profile{}).Count(&totalProfiles)
 
 	offset := (page - 1) * limit
-	err := query.Order("created ASC").Limit(limit).Offset(offset).Find(&profiles).Error
+	if filter.SortBy == application.ProfileSortEndorsements {
+		query = query.Order("(SELECT COUNT(*) FROM endorsements JOIN skills ON skills.id = endorsements.skill_id WHERE skills.owner_id = profiles.id AND skills.deleted_at IS NULL) DESC")
+	}
+	err := query.Order("created_at ASC").Limit(limit).Offset(offset).Find(&profiles).Error
 	if err != nil {
 		return nil, 0, err
 	}
@@ -126,21 +175,12 @@ func (r *GormSkillRepository) FindSkillByID(id uuid.UUID) (*domain.Skill, error)
 	return &skill, nil
 }
 
-// FindUserSkill retrieves a skill for a specific user.
-func (r *GormSkillRepository) FindUserSkill(skillID, userID uuid.UUID) (*domain.Skill, error) {
-	var skill domain.Skill
-	if err := r.DB.Where("owner_id IN (SELECT id FROM profiles WHERE user_id = ?)", userID).First(&skill, "id = ?", skillID).Error; err != nil {
-		return nil, err
-	}
-	return &skill, nil
-}
-
 // UpdateSkill updates an existing skill.
 func (r *GormSkillRepository) UpdateSkill(skill *domain.Skill) error {
 	return r.DB.Save(skill).Error
 }
 
-// DeleteSkill deletes a skill by its ID.
+// DeleteSkill moves a skill to the trash by its ID.
 func (r *GormSkillRepository) DeleteSkill(id uuid.UUID) error {
 	return r.DB.Delete(&domain.Skill{}, "id = ?", id).Error
 }
@@ -164,10 +204,10 @@ func (r *GormMessageRepository) FindMessagesByRecipientID(recipientID uuid.UUID)
 	return messages, nil
 }
 
-// FindMessageByIDAndRecipientID retrieves a single message by ID and recipient ID.
-func (r *GormMessageRepository) FindMessageByIDAndRecipientID(messageID, recipientID uuid.UUID) (*domain.Message, error) {
+// FindMessageByID retrieves a single message by ID.
+func (r *GormMessageRepository) FindMessageByID(id uuid.UUID) (*domain.Message, error) {
 	var message domain.Message
-	if err := r.DB.Preload("Sender").Preload("Recipient").Where("recipient_id = ?", recipientID).First(&message, "id = ?", messageID).Error; err != nil {
+	if err := r.DB.Preload("Sender").Preload("Recipient").First(&message, "id = ?", id).Error; err != nil {
 		return nil, err
 	}
 	return &message, nil
@@ -1,6 +1,7 @@
 package utils
 
 import (
+	"devsearch-go/internal/application"
 	"devsearch-go/internal/domain"
 
 	"github.com/gin-contrib/sessions"
@@ -41,6 +42,7 @@ type TemplateData struct {
 	FlashError      []string
 	FlashInfo       []string
 	IsAuthenticated bool
+	IsStaff         bool // Shows the admin console link
 	// Page specific data
 	Profile         domain.Profile
 	Profiles        []domain.Profile
@@ -48,14 +50,71 @@ type TemplateData struct {
 	Projects        []domain.Project
 	Skill           domain.Skill // Added for skill forms
 	Skills          []domain.Skill
+	SkillLevels     []string
+	Experience      domain.Experience
+	Education       domain.Education
 	TopSkills       []domain.Skill
 	OtherSkills     []domain.Skill
 	Message         domain.Message
 	MessageRequests []domain.Message
 	Recipient       domain.Profile
 
-	SearchQuery string
-	Pagination  PaginationData
+	SearchQuery   string
+	ProfileFilter application.ProfileFilter
+	Pagination    PaginationData
+	Analytics     *application.AnalyticsReport
+
+	ActivityEvents []domain.ActivityEvent
+	NextCursor     string
+	IsFollowing    bool
+	FollowerCount  int64
+
+	Shortlist      domain.Shortlist
+	Shortlists     []domain.Shortlist
+	PipelineStages []string
+	SavedSearches  []application.SavedSearchSummary
+
+	JobPosting        domain.JobPosting
+	JobPostings       []domain.JobPosting
+	JobPostingFilter  application.JobPostingFilter
+	JobMatch          *application.JobMatch
+	ProfileMatches    []application.ProfileMatch
+	JobPostingMatches []application.JobPostingMatch
+
+	SimilarProfiles []domain.SimilarProfile
+	RelatedProjects []domain.RelatedProject
+
+	CollaboratorRoles      []string
+	Invitations            []domain.ProjectCollaborator
+	CanEditProject         bool
+	CanDeleteProject       bool
+	CanManageCollaborators bool
+	CanRevertProject       bool
+	ProjectStatuses        []string
+	ProjectImport          *application.ProjectImport
+	LinkHealth             []application.LinkHealthEntry
+	BrokenLinkCount        int
+	ProjectHistory         []application.ProjectRevisionEntry
+
+	Users          []domain.User
+	Reviews        []domain.Review
+	UserRoles      []string
+	CanManageRoles bool
+	AdminSection   string
+
+	ReportReasons      []string
+	ReportCases        []domain.ReportCase
+	ReportCaseStatuses []string
+	ReportStatus       string
+	OpenReportCount    int64
+
+	AuditEvents     []domain.AuditEvent
+	AuditFilter     application.AuditFilter
+	AuditActions    []string
+	CanViewAuditLog bool
+
+	Trash              []application.TrashEntry
+	TrashRetentionDays int
 
 	UnreadCount int64
 	FormTitle   string
@@ -65,6 +124,7 @@ type TemplateData struct {
 	IsOwner       bool
 	HasReviewed   bool
 	Page          string // For login/register page differentiation
+	RequestURI    string // Path and query of the current page, for forms that return to it
 }
 
 // GetTemplateData initializes common template data, including flash messages and authentication status.
@@ -74,5 +134,7 @@ func GetTemplateData(c *gin.Context, isAuthenticated bool) TemplateData {
 		FlashError:      GetFlashMessages(c, FlashError),
 		FlashInfo:       GetFlashMessages(c, FlashInfo),
 		IsAuthenticated: isAuthenticated,
+		IsStaff:         isAuthenticated && domain.IsStaffRole(c.GetString("userRole")),
+		RequestURI:      c.Request.URL.RequestURI(),
 	}
 }
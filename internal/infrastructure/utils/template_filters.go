@@ -3,6 +3,7 @@ package utils
 import (
 	"html/template"
 	"strings"
+	"time"
 )
 
 // Pluralize returns the plural form of a word if count is not 1.
@@ -26,3 +27,11 @@ func Linebreaksbr(text string) template.HTML {
 	replaced := strings.ReplaceAll(text, "\n", "<br>")
 	return template.HTML(replaced)
 }
+
+// FormatDate formats a time with the given layout, returning an empty string for the zero time.
+func FormatDate(t time.Time, layout string) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.Format(layout)
+}
@@ -0,0 +1,247 @@
+package http
+
+import (
+	"errors"
+	"log"
+	"net/http"
+	"strconv"
+
+	"devsearch-go/internal/application"
+	"devsearch-go/internal/domain"
+	"devsearch-go/internal/infrastructure/utils"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// adminPageSize is the number of rows per page in the admin console.
+const adminPageSize = 20
+
+// RenderAdminPage handles the admin console landing page, which opens on the list of users
+func (h *Handler) RenderAdminPage(c *gin.Context) {
+	c.Redirect(http.StatusFound, "/admin/users")
+}
+
+// RenderAdminUsersPage renders the admin console list of users
+func (h *Handler) RenderAdminUsersPage(c *gin.Context) {
+	userID, ok := sessionUserID(c, "Failed to load users")
+	if !ok {
+		return
+	}
+	searchQuery := c.Query("search_query")
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+
+	users, total, err := h.AdminUseCase.GetUsers(userID, searchQuery, page, adminPageSize)
+	if h.adminAccessDenied(c, err, "Failed to load users") {
+		return
+	}
+
+	data := h.adminTemplateData(c, "users", searchQuery, total)
+	data.Users = users
+	data.UserRoles = domain.UserRoles
+	// Only admins change roles; a user with no role yet stands in for any non-staff account.
+	data.CanManageRoles = application.Can(viewerPrincipal(c), application.ActionManageRoles, &domain.User{})
+	c.HTML(http.StatusOK, "admin/admin.html", data)
+}
+
+// RenderAdminProjectsPage renders the admin console list of projects, hidden ones included
+func (h *Handler) RenderAdminProjectsPage(c *gin.Context) {
+	userID, ok := sessionUserID(c, "Failed to load projects")
+	if !ok {
+		return
+	}
+	searchQuery := c.Query("search_query")
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+
+	projects, total, err := h.AdminUseCase.GetProjects(userID, searchQuery, page, adminPageSize)
+	if h.adminAccessDenied(c, err, "Failed to load projects") {
+		return
+	}
+
+	data := h.adminTemplateData(c, "projects", searchQuery, total)
+	data.Projects = projects
+	c.HTML(http.StatusOK, "admin/admin.html", data)
+}
+
+// RenderAdminReviewsPage renders the admin console list of reviews
+func (h *Handler) RenderAdminReviewsPage(c *gin.Context) {
+	userID, ok := sessionUserID(c, "Failed to load reviews")
+	if !ok {
+		return
+	}
+	searchQuery := c.Query("search_query")
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+
+	reviews, total, err := h.AdminUseCase.GetReviews(userID, searchQuery, page, adminPageSize)
+	if h.adminAccessDenied(c, err, "Failed to load reviews") {
+		return
+	}
+
+	data := h.adminTemplateData(c, "reviews", searchQuery, total)
+	data.Reviews = reviews
+	c.HTML(http.StatusOK, "admin/admin.html", data)
+}
+
+// SuspendUser handles suspending a user account from the admin console
+func (h *Handler) SuspendUser(c *gin.Context) {
+	h.setUserSuspended(c, true)
+}
+
+// ReinstateUser handles lifting the suspension of a user account from the admin console
+func (h *Handler) ReinstateUser(c *gin.Context) {
+	h.setUserSuspended(c, false)
+}
+
+// setUserSuspended suspends or reinstates the user in the route and returns to the admin console.
+func (h *Handler) setUserSuspended(c *gin.Context, suspended bool) {
+	actorID, ok := sessionUserID(c, "Failed to update user")
+	if !ok {
+		return
+	}
+	userID, ok := adminIDParam(c, "/admin/users")
+	if !ok {
+		return
+	}
+
+	user, err := h.AdminUseCase.SetUserSuspended(actorID, userID, suspended, requestMeta(c))
+	switch {
+	case err == nil && suspended:
+		utils.SetFlashMessage(c, utils.FlashSuccess, user.Username+" was suspended")
+	case err == nil:
+		utils.SetFlashMessage(c, utils.FlashSuccess, user.Username+" was reinstated")
+	case errors.Is(err, application.ErrUserNotFound), errors.Is(err, application.ErrPermissionDenied):
+		utils.SetFlashMessage(c, utils.FlashError, err.Error())
+	default:
+		log.Printf("Failed to update suspension of user %s by %s: %v", userID.String(), actorID.String(), err)
+		utils.SetFlashMessage(c, utils.FlashError, "Failed to update user")
+	}
+	c.Redirect(http.StatusFound, localRedirectTarget(c.PostForm("next"), "/admin/users"))
+}
+
+// UpdateUserRole handles changing the role of a user from the admin console
+func (h *Handler) UpdateUserRole(c *gin.Context) {
+	actorID, ok := sessionUserID(c, "Failed to update user")
+	if !ok {
+		return
+	}
+	userID, ok := adminIDParam(c, "/admin/users")
+	if !ok {
+		return
+	}
+
+	user, err := h.AdminUseCase.SetUserRole(actorID, userID, c.PostForm("role"), requestMeta(c))
+	switch {
+	case err == nil:
+		utils.SetFlashMessage(c, utils.FlashSuccess, user.Username+" is now a "+user.Role)
+	case errors.Is(err, application.ErrInvalidUserRole), errors.Is(err, application.ErrUserNotFound), errors.Is(err, application.ErrPermissionDenied):
+		utils.SetFlashMessage(c, utils.FlashError, err.Error())
+	default:
+		log.Printf("Failed to update role of user %s by %s: %v", userID.String(), actorID.String(), err)
+		utils.SetFlashMessage(c, utils.FlashError, "Failed to update user")
+	}
+	c.Redirect(http.StatusFound, localRedirectTarget(c.PostForm("next"), "/admin/users"))
+}
+
+// HideProject handles hiding a project from the admin console
+func (h *Handler) HideProject(c *gin.Context) {
+	h.setProjectHidden(c, true)
+}
+
+// UnhideProject handles making a hidden project visible again from the admin console
+func (h *Handler) UnhideProject(c *gin.Context) {
+	h.setProjectHidden(c, false)
+}
+
+// setProjectHidden hides or shows the project in the route and returns to the admin console.
+func (h *Handler) setProjectHidden(c *gin.Context, hidden bool) {
+	actorID, ok := sessionUserID(c, "Failed to update project")
+	if !ok {
+		return
+	}
+	projectID, ok := adminIDParam(c, "/admin/projects")
+	if !ok {
+		return
+	}
+
+	project, err := h.AdminUseCase.SetProjectHidden(actorID, projectID, hidden, requestMeta(c))
+	switch {
+	case err == nil && hidden:
+		utils.SetFlashMessage(c, utils.FlashSuccess, "\""+project.Title+"\" was hidden")
+	case err == nil:
+		utils.SetFlashMessage(c, utils.FlashSuccess, "\""+project.Title+"\" is visible again")
+	case errors.Is(err, application.ErrProjectNotFound), errors.Is(err, application.ErrPermissionDenied):
+		utils.SetFlashMessage(c, utils.FlashError, err.Error())
+	default:
+		log.Printf("Failed to update visibility of project %s by %s: %v", projectID.String(), actorID.String(), err)
+		utils.SetFlashMessage(c, utils.FlashError, "Failed to update project")
+	}
+	c.Redirect(http.StatusFound, localRedirectTarget(c.PostForm("next"), "/admin/projects"))
+}
+
+// DeleteReviewAsStaff handles deleting a review from the admin console
+func (h *Handler) DeleteReviewAsStaff(c *gin.Context) {
+	actorID, ok := sessionUserID(c, "Failed to delete review")
+	if !ok {
+		return
+	}
+	reviewID, ok := adminIDParam(c, "/admin/reviews")
+	if !ok {
+		return
+	}
+
+	err := h.AdminUseCase.DeleteReview(actorID, reviewID, requestMeta(c))
+	switch {
+	case err == nil:
+		utils.SetFlashMessage(c, utils.FlashSuccess, "Review deleted")
+	case errors.Is(err, application.ErrReviewNotFound), errors.Is(err, application.ErrPermissionDenied):
+		utils.SetFlashMessage(c, utils.FlashError, err.Error())
+	default:
+		log.Printf("Failed to delete review %s by %s: %v", reviewID.String(), actorID.String(), err)
+		utils.SetFlashMessage(c, utils.FlashError, "Failed to delete review")
+	}
+	c.Redirect(http.StatusFound, localRedirectTarget(c.PostForm("next"), "/admin/reviews"))
+}
+
+// adminTemplateData prepares the template data shared by the sections of the admin console.
+func (h *Handler) adminTemplateData(c *gin.Context, section, searchQuery string, total int64) utils.TemplateData {
+	data := utils.GetTemplateData(c, true)
+	data.AdminSection = section
+	data.SearchQuery = searchQuery
+	data.Pagination = utils.Paginate(c, int(total), adminPageSize)
+	data.CurrentUserID = viewerID(c)
+	openReports, err := h.ReportUseCase.CountOpenReportCases()
+	if err != nil {
+		log.Printf("Failed to count open report cases: %v", err)
+	}
+	data.OpenReportCount = openReports
+	data.CanViewAuditLog = application.Can(viewerPrincipal(c), application.ActionView, &domain.AuditEvent{})
+	return data
+}
+
+// adminAccessDenied redirects away from the admin console and reports true when loading a
+// section failed.
+func (h *Handler) adminAccessDenied(c *gin.Context, err error, failureMessage string) bool {
+	switch {
+	case err == nil:
+		return false
+	case errors.Is(err, application.ErrPermissionDenied):
+		utils.SetFlashMessage(c, utils.FlashError, err.Error())
+	default:
+		log.Printf("%s: %v", failureMessage, err)
+		utils.SetFlashMessage(c, utils.FlashError, failureMessage)
+	}
+	c.Redirect(http.StatusFound, "/")
+	return true
+}
+
+// adminIDParam parses the ID route parameter of an admin action, redirecting to the given
+// section when it is invalid.
+func adminIDParam(c *gin.Context, section string) (uuid.UUID, bool) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.SetFlashMessage(c, utils.FlashError, "Invalid ID")
+		c.Redirect(http.StatusFound, section)
+		return uuid.Nil, false
+	}
+	return id, true
+}
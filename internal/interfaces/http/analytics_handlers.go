@@ -0,0 +1,29 @@
+package http
+
+import (
+	"log"
+
+	"devsearch-go/internal/application"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// recordView counts the current request as a view of a profile or project page.
+// Failures are only logged: analytics must never keep a page from rendering.
+func (h *Handler) recordView(c *gin.Context, kind string, targetID, ownerID uuid.UUID) {
+	// Browsers speculatively prefetching a link have not shown the page to anyone
+	if c.GetHeader("Purpose") == "prefetch" || c.GetHeader("Sec-Purpose") != "" {
+		return
+	}
+
+	visitor := application.Visitor{
+		UserID:    viewerID(c),
+		IP:        c.ClientIP(),
+		UserAgent: c.Request.UserAgent(),
+		Referrer:  c.Request.Referer(),
+	}
+	if err := h.AnalyticsUseCase.RecordView(kind, targetID, ownerID, visitor); err != nil {
+		log.Printf("Failed to record %s view of %s: %v", kind, targetID.String(), err)
+	}
+}
@@ -0,0 +1,74 @@
+package http
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"devsearch-go/internal/application"
+	"devsearch-go/internal/domain"
+
+	"github.com/gin-gonic/gin"
+)
+
+// RenderAdminAuditPage renders the audit log section of the admin console
+func (h *Handler) RenderAdminAuditPage(c *gin.Context) {
+	userID, ok := sessionUserID(c, "Failed to load audit log")
+	if !ok {
+		return
+	}
+	filter := auditFilterFromQuery(c)
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+
+	events, total, err := h.AuditUseCase.GetAuditEvents(userID, filter, page, adminPageSize)
+	if h.adminAccessDenied(c, err, "Failed to load audit log") {
+		return
+	}
+
+	data := h.adminTemplateData(c, "audit", "", total)
+	data.AuditEvents = events
+	data.AuditFilter = filter
+	data.AuditActions = domain.AuditActions
+	c.HTML(http.StatusOK, "admin/admin.html", data)
+}
+
+// ExportAuditLogCSV handles downloading the filtered audit log as a CSV file
+func (h *Handler) ExportAuditLogCSV(c *gin.Context) {
+	userID, ok := sessionUserID(c, "Failed to export audit log")
+	if !ok {
+		return
+	}
+
+	var buf bytes.Buffer
+	err := h.AuditUseCase.ExportCSV(&buf, userID, auditFilterFromQuery(c))
+	if h.adminAccessDenied(c, err, "Failed to export audit log") {
+		return
+	}
+
+	c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=\"audit-log-%s.csv\"", time.Now().Format(time.DateOnly)))
+	c.Data(http.StatusOK, "text/csv; charset=utf-8", buf.Bytes())
+}
+
+// auditFilterFromQuery reads the audit log filter from the query string. Dates that do not
+// parse are ignored.
+func auditFilterFromQuery(c *gin.Context) application.AuditFilter {
+	filter := application.AuditFilter{
+		Actor:  c.Query("actor"),
+		Action: c.Query("action"),
+		Target: c.Query("target"),
+	}
+	if from, err := time.Parse(time.DateOnly, c.Query("from")); err == nil {
+		filter.From = from
+	}
+	if to, err := time.Parse(time.DateOnly, c.Query("to")); err == nil {
+		filter.To = to
+	}
+	return filter
+}
+
+// requestMeta returns where the current request came from, for the audit log.
+func requestMeta(c *gin.Context) application.RequestMeta {
+	return application.RequestMeta{IP: c.ClientIP(), UserAgent: c.Request.UserAgent()}
+}
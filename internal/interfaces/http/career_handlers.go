@@ -0,0 +1,433 @@
+package http
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+
+	"devsearch-go/internal/domain"
+	"devsearch-go/internal/infrastructure/utils"
+
+	"github.com/gin-contrib/sessions"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// monthInputLayout is the value format of <input type="month"> fields.
+const monthInputLayout = "2006-01"
+
+// CreateExperience handles adding a work experience entry to the user's profile
+func (h *Handler) CreateExperience(c *gin.Context) {
+	userIDStr := sessions.Default(c).Get("userID")
+	if userIDStr == nil {
+		utils.SetFlashMessage(c, utils.FlashError, "User not authenticated")
+		c.Redirect(http.StatusFound, "/login")
+		return
+	}
+	userID, err := uuid.Parse(userIDStr.(string))
+	if err != nil {
+		log.Printf("Invalid user ID in session: %v", err)
+		utils.SetFlashMessage(c, utils.FlashError, "Failed to add experience")
+		c.Redirect(http.StatusFound, "/login")
+		return
+	}
+
+	experience, err := experienceFromForm(c)
+	if err != nil {
+		utils.SetFlashMessage(c, utils.FlashError, err.Error())
+		c.Redirect(http.StatusFound, "/create-experience")
+		return
+	}
+
+	if err := h.CareerUseCase.CreateExperience(userID, experience); err != nil {
+		log.Printf("Failed to create experience for user %s: %v", userID.String(), err)
+		utils.SetFlashMessage(c, utils.FlashError, err.Error())
+		c.Redirect(http.StatusFound, "/create-experience")
+		return
+	}
+
+	utils.SetFlashMessage(c, utils.FlashSuccess, "Experience was added successfully!")
+	c.Redirect(http.StatusFound, "/account")
+}
+
+// UpdateExperience handles updating a work experience entry
+func (h *Handler) UpdateExperience(c *gin.Context) {
+	userIDStr := sessions.Default(c).Get("userID")
+	if userIDStr == nil {
+		utils.SetFlashMessage(c, utils.FlashError, "User not authenticated")
+		c.Redirect(http.StatusFound, "/login")
+		return
+	}
+	userID, err := uuid.Parse(userIDStr.(string))
+	if err != nil {
+		log.Printf("Invalid user ID in session: %v", err)
+		utils.SetFlashMessage(c, utils.FlashError, "Failed to update experience")
+		c.Redirect(http.StatusFound, "/login")
+		return
+	}
+
+	idStr := c.Param("id")
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		utils.SetFlashMessage(c, utils.FlashError, "Invalid experience ID")
+		c.Redirect(http.StatusFound, "/account")
+		return
+	}
+
+	experience, err := experienceFromForm(c)
+	if err != nil {
+		utils.SetFlashMessage(c, utils.FlashError, err.Error())
+		c.Redirect(http.StatusFound, fmt.Sprintf("/update-experience/%s", idStr))
+		return
+	}
+
+	if _, err := h.CareerUseCase.UpdateExperience(id, userID, experience); err != nil {
+		log.Printf("Failed to update experience %s for user %s: %v", idStr, userID.String(), err)
+		utils.SetFlashMessage(c, utils.FlashError, err.Error())
+		c.Redirect(http.StatusFound, fmt.Sprintf("/update-experience/%s", idStr))
+		return
+	}
+
+	utils.SetFlashMessage(c, utils.FlashSuccess, "Experience was updated successfully!")
+	c.Redirect(http.StatusFound, "/account")
+}
+
+// DeleteExperience handles deleting a work experience entry
+func (h *Handler) DeleteExperience(c *gin.Context) {
+	userIDStr := sessions.Default(c).Get("userID")
+	if userIDStr == nil {
+		utils.SetFlashMessage(c, utils.FlashError, "User not authenticated")
+		c.Redirect(http.StatusFound, "/login")
+		return
+	}
+	userID, err := uuid.Parse(userIDStr.(string))
+	if err != nil {
+		log.Printf("Invalid user ID in session: %v", err)
+		utils.SetFlashMessage(c, utils.FlashError, "Failed to delete experience")
+		c.Redirect(http.StatusFound, "/login")
+		return
+	}
+
+	idStr := c.Param("id")
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		utils.SetFlashMessage(c, utils.FlashError, "Invalid experience ID")
+		c.Redirect(http.StatusFound, "/account")
+		return
+	}
+
+	if err := h.CareerUseCase.DeleteExperience(id, userID); err != nil {
+		log.Printf("Failed to delete experience %s for user %s: %v", idStr, userID.String(), err)
+		utils.SetFlashMessage(c, utils.FlashError, err.Error())
+		c.Redirect(http.StatusFound, "/account")
+		return
+	}
+
+	utils.SetFlashMessage(c, utils.FlashSuccess, "Experience was deleted successfully!")
+	c.Redirect(http.StatusFound, "/account")
+}
+
+// CreateEducation handles adding an education entry to the user's profile
+func (h *Handler) CreateEducation(c *gin.Context) {
+	userIDStr := sessions.Default(c).Get("userID")
+	if userIDStr == nil {
+		utils.SetFlashMessage(c, utils.FlashError, "User not authenticated")
+		c.Redirect(http.StatusFound, "/login")
+		return
+	}
+	userID, err := uuid.Parse(userIDStr.(string))
+	if err != nil {
+		log.Printf("Invalid user ID in session: %v", err)
+		utils.SetFlashMessage(c, utils.FlashError, "Failed to add education")
+		c.Redirect(http.StatusFound, "/login")
+		return
+	}
+
+	education, err := educationFromForm(c)
+	if err != nil {
+		utils.SetFlashMessage(c, utils.FlashError, err.Error())
+		c.Redirect(http.StatusFound, "/create-education")
+		return
+	}
+
+	if err := h.CareerUseCase.CreateEducation(userID, education); err != nil {
+		log.Printf("Failed to create education for user %s: %v", userID.String(), err)
+		utils.SetFlashMessage(c, utils.FlashError, err.Error())
+		c.Redirect(http.StatusFound, "/create-education")
+		return
+	}
+
+	utils.SetFlashMessage(c, utils.FlashSuccess, "Education was added successfully!")
+	c.Redirect(http.StatusFound, "/account")
+}
+
+// UpdateEducation handles updating an education entry
+func (h *Handler) UpdateEducation(c *gin.Context) {
+	userIDStr := sessions.Default(c).Get("userID")
+	if userIDStr == nil {
+		utils.SetFlashMessage(c, utils.FlashError, "User not authenticated")
+		c.Redirect(http.StatusFound, "/login")
+		return
+	}
+	userID, err := uuid.Parse(userIDStr.(string))
+	if err != nil {
+		log.Printf("Invalid user ID in session: %v", err)
+		utils.SetFlashMessage(c, utils.FlashError, "Failed to update education")
+		c.Redirect(http.StatusFound, "/login")
+		return
+	}
+
+	idStr := c.Param("id")
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		utils.SetFlashMessage(c, utils.FlashError, "Invalid education ID")
+		c.Redirect(http.StatusFound, "/account")
+		return
+	}
+
+	education, err := educationFromForm(c)
+	if err != nil {
+		utils.SetFlashMessage(c, utils.FlashError, err.Error())
+		c.Redirect(http.StatusFound, fmt.Sprintf("/update-education/%s", idStr))
+		return
+	}
+
+	if _, err := h.CareerUseCase.UpdateEducation(id, userID, education); err != nil {
+		log.Printf("Failed to update education %s for user %s: %v", idStr, userID.String(), err)
+		utils.SetFlashMessage(c, utils.FlashError, err.Error())
+		c.Redirect(http.StatusFound, fmt.Sprintf("/update-education/%s", idStr))
+		return
+	}
+
+	utils.SetFlashMessage(c, utils.FlashSuccess, "Education was updated successfully!")
+	c.Redirect(http.StatusFound, "/account")
+}
+
+// DeleteEducation handles deleting an education entry
+func (h *Handler) DeleteEducation(c *gin.Context) {
+	userIDStr := sessions.Default(c).Get("userID")
+	if userIDStr == nil {
+		utils.SetFlashMessage(c, utils.FlashError, "User not authenticated")
+		c.Redirect(http.StatusFound, "/login")
+		return
+	}
+	userID, err := uuid.Parse(userIDStr.(string))
+	if err != nil {
+		log.Printf("Invalid user ID in session: %v", err)
+		utils.SetFlashMessage(c, utils.FlashError, "Failed to delete education")
+		c.Redirect(http.StatusFound, "/login")
+		return
+	}
+
+	idStr := c.Param("id")
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		utils.SetFlashMessage(c, utils.FlashError, "Invalid education ID")
+		c.Redirect(http.StatusFound, "/account")
+		return
+	}
+
+	if err := h.CareerUseCase.DeleteEducation(id, userID); err != nil {
+		log.Printf("Failed to delete education %s for user %s: %v", idStr, userID.String(), err)
+		utils.SetFlashMessage(c, utils.FlashError, err.Error())
+		c.Redirect(http.StatusFound, "/account")
+		return
+	}
+
+	utils.SetFlashMessage(c, utils.FlashSuccess, "Education was deleted successfully!")
+	c.Redirect(http.StatusFound, "/account")
+}
+
+// RenderCreateExperiencePage renders the create experience page
+func (h *Handler) RenderCreateExperiencePage(c *gin.Context) {
+	session := sessions.Default(c)
+	userIDStr := session.Get("userID")
+	isAuthenticated := userIDStr != nil
+
+	data := utils.GetTemplateData(c, isAuthenticated)
+	data.FormTitle = "Add Experience"
+	c.HTML(http.StatusOK, "users/experience_form.html", data)
+}
+
+// RenderUpdateExperiencePage renders the update experience page
+func (h *Handler) RenderUpdateExperiencePage(c *gin.Context) {
+	experience, ok := h.loadUserExperience(c)
+	if !ok {
+		return
+	}
+
+	data := utils.GetTemplateData(c, true)
+	data.FormTitle = "Update Experience"
+	data.Experience = *experience
+	c.HTML(http.StatusOK, "users/experience_form.html", data)
+}
+
+// RenderDeleteExperiencePage renders the delete experience page
+func (h *Handler) RenderDeleteExperiencePage(c *gin.Context) {
+	experience, ok := h.loadUserExperience(c)
+	if !ok {
+		return
+	}
+
+	data := utils.GetTemplateData(c, true)
+	data.Object = experience
+	c.HTML(http.StatusOK, "delete.html", data)
+}
+
+// RenderCreateEducationPage renders the create education page
+func (h *Handler) RenderCreateEducationPage(c *gin.Context) {
+	session := sessions.Default(c)
+	userIDStr := session.Get("userID")
+	isAuthenticated := userIDStr != nil
+
+	data := utils.GetTemplateData(c, isAuthenticated)
+	data.FormTitle = "Add Education"
+	c.HTML(http.StatusOK, "users/education_form.html", data)
+}
+
+// RenderUpdateEducationPage renders the update education page
+func (h *Handler) RenderUpdateEducationPage(c *gin.Context) {
+	education, ok := h.loadUserEducation(c)
+	if !ok {
+		return
+	}
+
+	data := utils.GetTemplateData(c, true)
+	data.FormTitle = "Update Education"
+	data.Education = *education
+	c.HTML(http.StatusOK, "users/education_form.html", data)
+}
+
+// RenderDeleteEducationPage renders the delete education page
+func (h *Handler) RenderDeleteEducationPage(c *gin.Context) {
+	education, ok := h.loadUserEducation(c)
+	if !ok {
+		return
+	}
+
+	data := utils.GetTemplateData(c, true)
+	// delete.html prints the object's Title or Name, which Education does not have.
+	data.Object = gin.H{"Title": strings.TrimSpace(education.Degree + " " + education.School)}
+	c.HTML(http.StatusOK, "delete.html", data)
+}
+
+// loadUserExperience loads the experience from the URL for the authenticated user,
+// redirecting with a flash message when it cannot be loaded.
+func (h *Handler) loadUserExperience(c *gin.Context) (*domain.Experience, bool) {
+	userIDStr := sessions.Default(c).Get("userID")
+	if userIDStr == nil {
+		utils.SetFlashMessage(c, utils.FlashError, "User not authenticated")
+		c.Redirect(http.StatusFound, "/login")
+		return nil, false
+	}
+	userID, err := uuid.Parse(userIDStr.(string))
+	if err != nil {
+		log.Printf("Invalid user ID in session: %v", err)
+		utils.SetFlashMessage(c, utils.FlashError, "Failed to get experience")
+		c.Redirect(http.StatusFound, "/login")
+		return nil, false
+	}
+
+	idStr := c.Param("id")
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		utils.SetFlashMessage(c, utils.FlashError, "Invalid experience ID")
+		c.Redirect(http.StatusFound, "/account")
+		return nil, false
+	}
+
+	experience, err := h.CareerUseCase.GetUserExperience(id, userID)
+	if err != nil {
+		log.Printf("Experience not found for ID %s: %v", idStr, err)
+		utils.SetFlashMessage(c, utils.FlashError, "Experience not found")
+		c.Redirect(http.StatusFound, "/account")
+		return nil, false
+	}
+	return experience, true
+}
+
+// loadUserEducation loads the education entry from the URL for the authenticated user,
+// redirecting with a flash message when it cannot be loaded.
+func (h *Handler) loadUserEducation(c *gin.Context) (*domain.Education, bool) {
+	userIDStr := sessions.Default(c).Get("userID")
+	if userIDStr == nil {
+		utils.SetFlashMessage(c, utils.FlashError, "User not authenticated")
+		c.Redirect(http.StatusFound, "/login")
+		return nil, false
+	}
+	userID, err := uuid.Parse(userIDStr.(string))
+	if err != nil {
+		log.Printf("Invalid user ID in session: %v", err)
+		utils.SetFlashMessage(c, utils.FlashError, "Failed to get education")
+		c.Redirect(http.StatusFound, "/login")
+		return nil, false
+	}
+
+	idStr := c.Param("id")
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		utils.SetFlashMessage(c, utils.FlashError, "Invalid education ID")
+		c.Redirect(http.StatusFound, "/account")
+		return nil, false
+	}
+
+	education, err := h.CareerUseCase.GetUserEducation(id, userID)
+	if err != nil {
+		log.Printf("Education not found for ID %s: %v", idStr, err)
+		utils.SetFlashMessage(c, utils.FlashError, "Education not found")
+		c.Redirect(http.StatusFound, "/account")
+		return nil, false
+	}
+	return education, true
+}
+
+// experienceFromForm builds an experience from the submitted form fields.
+func experienceFromForm(c *gin.Context) (*domain.Experience, error) {
+	startDate, endDate, err := parseDateRange(c.PostForm("start_date"), c.PostForm("end_date"))
+	if err != nil {
+		return nil, err
+	}
+	return &domain.Experience{
+		Company:      strings.TrimSpace(c.PostForm("company")),
+		Title:        strings.TrimSpace(c.PostForm("title")),
+		Location:     strings.TrimSpace(c.PostForm("location")),
+		StartDate:    startDate,
+		EndDate:      endDate,
+		Description:  c.PostForm("description"),
+		Technologies: c.PostForm("technologies"),
+	}, nil
+}
+
+// educationFromForm builds an education entry from the submitted form fields.
+func educationFromForm(c *gin.Context) (*domain.Education, error) {
+	startDate, endDate, err := parseDateRange(c.PostForm("start_date"), c.PostForm("end_date"))
+	if err != nil {
+		return nil, err
+	}
+	return &domain.Education{
+		School:       strings.TrimSpace(c.PostForm("school")),
+		Degree:       strings.TrimSpace(c.PostForm("degree")),
+		FieldOfStudy: strings.TrimSpace(c.PostForm("field_of_study")),
+		StartDate:    startDate,
+		EndDate:      endDate,
+		Description:  c.PostForm("description"),
+	}, nil
+}
+
+// parseDateRange parses month inputs; an empty end date means the entry is ongoing.
+func parseDateRange(start, end string) (time.Time, *time.Time, error) {
+	startDate, err := time.Parse(monthInputLayout, start)
+	if err != nil {
+		return time.Time{}, nil, fmt.Errorf("invalid start date")
+	}
+	if end == "" {
+		return startDate, nil, nil
+	}
+	endDate, err := time.Parse(monthInputLayout, end)
+	if err != nil {
+		return time.Time{}, nil, fmt.Errorf("invalid end date")
+	}
+	return startDate, &endDate, nil
+}
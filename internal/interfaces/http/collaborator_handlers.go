@@ -0,0 +1,193 @@
+package http
+
+import (
+	"errors"
+	"log"
+	"net/http"
+
+	"devsearch-go/internal/application"
+	"devsearch-go/internal/infrastructure/utils"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// InviteCollaborator handles inviting a developer to collaborate on a project
+func (h *Handler) InviteCollaborator(c *gin.Context) {
+	userID, ok := sessionUserID(c, "Failed to invite collaborator")
+	if !ok {
+		return
+	}
+	projectID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.SetFlashMessage(c, utils.FlashError, "Invalid project ID")
+		c.Redirect(http.StatusFound, "/projects")
+		return
+	}
+
+	_, err = h.CollaboratorUseCase.InviteCollaborator(userID, projectID, c.PostForm("username"), c.PostForm("role"))
+	switch {
+	case err == nil:
+		utils.SetFlashMessage(c, utils.FlashSuccess, "Invitation sent!")
+	case errors.Is(err, application.ErrProjectNotFound):
+		utils.SetFlashMessage(c, utils.FlashError, "Project not found")
+		c.Redirect(http.StatusFound, "/projects")
+		return
+	case errors.Is(err, application.ErrPermissionDenied), errors.Is(err, application.ErrOwnerOnlyMaintainers),
+		errors.Is(err, application.ErrInvalidCollaboratorRole), errors.Is(err, application.ErrInviteeNotFound),
+		errors.Is(err, application.ErrInviteOwner), errors.Is(err, application.ErrAlreadyCollaborator):
+		utils.SetFlashMessage(c, utils.FlashError, err.Error())
+	default:
+		log.Printf("Failed to invite collaborator to project %s for user %s: %v", projectID.String(), userID.String(), err)
+		utils.SetFlashMessage(c, utils.FlashError, "Failed to invite collaborator")
+	}
+	c.Redirect(http.StatusFound, "/project/"+projectID.String())
+}
+
+// RemoveCollaborator handles removing a collaborator or invitation from a project, or leaving it
+func (h *Handler) RemoveCollaborator(c *gin.Context) {
+	userID, ok := sessionUserID(c, "Failed to remove collaborator")
+	if !ok {
+		return
+	}
+	collaboratorID, ok := collaboratorIDParam(c)
+	if !ok {
+		return
+	}
+
+	collaborator, err := h.CollaboratorUseCase.RemoveCollaborator(userID, collaboratorID)
+	switch {
+	case err == nil:
+		utils.SetFlashMessage(c, utils.FlashSuccess, "Collaborator removed")
+	case errors.Is(err, application.ErrCollaboratorNotFound), errors.Is(err, application.ErrProjectNotFound):
+		utils.SetFlashMessage(c, utils.FlashError, "Collaborator not found")
+		c.Redirect(http.StatusFound, "/account")
+		return
+	case errors.Is(err, application.ErrPermissionDenied):
+		utils.SetFlashMessage(c, utils.FlashError, err.Error())
+	default:
+		log.Printf("Failed to remove collaborator %s for user %s: %v", collaboratorID.String(), userID.String(), err)
+		utils.SetFlashMessage(c, utils.FlashError, "Failed to remove collaborator")
+	}
+	c.Redirect(http.StatusFound, collaborator.Project.URL())
+}
+
+// AcceptInvitation handles accepting an invitation to collaborate on a project
+func (h *Handler) AcceptInvitation(c *gin.Context) {
+	userID, ok := sessionUserID(c, "Failed to accept invitation")
+	if !ok {
+		return
+	}
+	invitationID, ok := collaboratorIDParam(c)
+	if !ok {
+		return
+	}
+
+	invitation, err := h.CollaboratorUseCase.AcceptInvitation(userID, invitationID)
+	if err != nil {
+		log.Printf("Failed to accept invitation %s for user %s: %v", invitationID.String(), userID.String(), err)
+		utils.SetFlashMessage(c, utils.FlashError, "Invitation not found")
+		c.Redirect(http.StatusFound, "/account")
+		return
+	}
+
+	utils.SetFlashMessage(c, utils.FlashSuccess, "You joined the project!")
+	c.Redirect(http.StatusFound, invitation.Project.URL())
+}
+
+// DeclineInvitation handles declining an invitation to collaborate on a project
+func (h *Handler) DeclineInvitation(c *gin.Context) {
+	userID, ok := sessionUserID(c, "Failed to decline invitation")
+	if !ok {
+		return
+	}
+	invitationID, ok := collaboratorIDParam(c)
+	if !ok {
+		return
+	}
+
+	if err := h.CollaboratorUseCase.DeclineInvitation(userID, invitationID); err != nil {
+		log.Printf("Failed to decline invitation %s for user %s: %v", invitationID.String(), userID.String(), err)
+		utils.SetFlashMessage(c, utils.FlashError, "Invitation not found")
+		c.Redirect(http.StatusFound, "/account")
+		return
+	}
+
+	utils.SetFlashMessage(c, utils.FlashSuccess, "Invitation declined")
+	c.Redirect(http.StatusFound, "/account")
+}
+
+// GetProjectCollaborators handles fetching the owner and accepted collaborators of a project
+func (h *Handler) GetProjectCollaborators(c *gin.Context) {
+	projectID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid project ID"})
+		return
+	}
+	project, err := h.ProjectUseCase.GetProjectByID(projectID)
+	if err != nil || !application.CanSeeProject(viewerPrincipal(c), project) {
+		c.JSON(http.StatusNotFound, gin.H{"error": "Project not found"})
+		return
+	}
+
+	response := []gin.H{{
+		"user_id":  project.OwnerID,
+		"name":     project.Owner.Name,
+		"username": project.Owner.Username,
+		"role":     application.ProjectRoleOwner,
+	}}
+	for _, collaborator := range project.Collaborators {
+		if !collaborator.IsAccepted() {
+			continue
+		}
+		response = append(response, gin.H{
+			"user_id":  collaborator.Profile.UserID,
+			"name":     collaborator.Profile.Name,
+			"username": collaborator.Profile.Username,
+			"url":      collaborator.Profile.URL(),
+			"role":     collaborator.Role,
+		})
+	}
+	c.JSON(http.StatusOK, response)
+}
+
+// GetInvitations handles fetching the user's pending project invitations
+func (h *Handler) GetInvitations(c *gin.Context) {
+	userID := viewerID(c)
+	if userID == uuid.Nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "User not authenticated"})
+		return
+	}
+
+	invitations, err := h.CollaboratorUseCase.GetPendingInvitations(userID)
+	if err != nil {
+		log.Printf("Failed to load project invitations for user %s: %v", userID.String(), err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to load invitations"})
+		return
+	}
+
+	response := make([]gin.H, 0, len(invitations))
+	for _, invitation := range invitations {
+		response = append(response, gin.H{
+			"id":            invitation.ID,
+			"project_id":    invitation.ProjectID,
+			"project_title": invitation.Project.Title,
+			"project_url":   invitation.Project.URL(),
+			"role":          invitation.Role,
+			"invited_at":    invitation.CreatedAt,
+		})
+	}
+	c.JSON(http.StatusOK, response)
+}
+
+// collaboratorIDParam parses the collaborator or invitation ID route parameter, redirecting to
+// the account page when it is invalid.
+func collaboratorIDParam(c *gin.Context) (uuid.UUID, bool) {
+	collaboratorID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.SetFlashMessage(c, utils.FlashError, "Invalid invitation ID")
+		c.Redirect(http.StatusFound, "/account")
+		return uuid.Nil, false
+	}
+	return collaboratorID, true
+}
@@ -0,0 +1,72 @@
+package http
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+
+	"devsearch-go/internal/application"
+	"devsearch-go/internal/domain"
+	"devsearch-go/internal/infrastructure/utils"
+
+	"github.com/gin-contrib/sessions"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// EndorseSkill handles endorsing another developer's skill
+func (h *Handler) EndorseSkill(c *gin.Context) {
+	h.changeEndorsement(c, h.EndorsementUseCase.EndorseSkill, "Skill was endorsed!")
+}
+
+// RevokeEndorsement handles withdrawing an endorsement of a skill
+func (h *Handler) RevokeEndorsement(c *gin.Context) {
+	h.changeEndorsement(c, h.EndorsementUseCase.RevokeEndorsement, "Endorsement was withdrawn")
+}
+
+// changeEndorsement runs an endorsement action for the authenticated user and redirects back to the skill's profile.
+func (h *Handler) changeEndorsement(c *gin.Context, action func(userID, skillID uuid.UUID) (*domain.Skill, error), successMessage string) {
+	userIDStr := sessions.Default(c).Get("userID")
+	if userIDStr == nil {
+		utils.SetFlashMessage(c, utils.FlashError, "User not authenticated")
+		c.Redirect(http.StatusFound, "/login")
+		return
+	}
+	userID, err := uuid.Parse(userIDStr.(string))
+	if err != nil {
+		log.Printf("Invalid user ID in session: %v", err)
+		utils.SetFlashMessage(c, utils.FlashError, "Failed to update endorsement")
+		c.Redirect(http.StatusFound, "/login")
+		return
+	}
+
+	idStr := c.Param("id")
+	skillID, err := uuid.Parse(idStr)
+	if err != nil {
+		utils.SetFlashMessage(c, utils.FlashError, "Invalid skill ID")
+		c.Redirect(http.StatusFound, "/profiles")
+		return
+	}
+
+	// The skill is only nil when it or the user's profile could not be loaded.
+	skill, err := action(userID, skillID)
+	if skill == nil {
+		log.Printf("Failed to update endorsement of skill %s for user %s: %v", idStr, userID.String(), err)
+		utils.SetFlashMessage(c, utils.FlashError, "Skill not found")
+		c.Redirect(http.StatusFound, "/profiles")
+		return
+	}
+
+	profileURL := fmt.Sprintf("/profile/%s", skill.OwnerID)
+	switch {
+	case err == nil:
+		utils.SetFlashMessage(c, utils.FlashSuccess, successMessage)
+	case errors.Is(err, application.ErrSelfEndorsement), errors.Is(err, application.ErrAlreadyEndorsed), errors.Is(err, application.ErrNotEndorsed):
+		utils.SetFlashMessage(c, utils.FlashInfo, err.Error())
+	default:
+		log.Printf("Failed to update endorsement of skill %s for user %s: %v", idStr, userID.String(), err)
+		utils.SetFlashMessage(c, utils.FlashError, "Failed to update endorsement")
+	}
+	c.Redirect(http.StatusFound, profileURL)
+}
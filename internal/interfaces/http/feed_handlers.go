@@ -0,0 +1,149 @@
+package http
+
+import (
+	"errors"
+	"log"
+	"net/http"
+	"strconv"
+	"time"
+
+	"devsearch-go/internal/application"
+	"devsearch-go/internal/domain"
+	"devsearch-go/internal/infrastructure/utils"
+
+	"github.com/gin-contrib/sessions"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// FollowProfile handles following another developer
+func (h *Handler) FollowProfile(c *gin.Context) {
+	h.changeFollow(c, h.ActivityUseCase.FollowProfile, "You are now following this developer")
+}
+
+// UnfollowProfile handles no longer following a developer
+func (h *Handler) UnfollowProfile(c *gin.Context) {
+	h.changeFollow(c, h.ActivityUseCase.UnfollowProfile, "You no longer follow this developer")
+}
+
+// changeFollow runs a follow action for the authenticated user and redirects back to the profile.
+func (h *Handler) changeFollow(c *gin.Context, action func(userID, profileID uuid.UUID) (*domain.Profile, error), successMessage string) {
+	userIDStr := sessions.Default(c).Get("userID")
+	if userIDStr == nil {
+		utils.SetFlashMessage(c, utils.FlashError, "User not authenticated")
+		c.Redirect(http.StatusFound, "/login")
+		return
+	}
+	userID, err := uuid.Parse(userIDStr.(string))
+	if err != nil {
+		log.Printf("Invalid user ID in session: %v", err)
+		utils.SetFlashMessage(c, utils.FlashError, "Failed to update follow")
+		c.Redirect(http.StatusFound, "/login")
+		return
+	}
+
+	idStr := c.Param("id")
+	profileID, err := uuid.Parse(idStr)
+	if err != nil {
+		utils.SetFlashMessage(c, utils.FlashError, "Invalid profile ID")
+		c.Redirect(http.StatusFound, "/profiles")
+		return
+	}
+
+	// The profile is only nil when it or the user's profile could not be loaded.
+	profile, err := action(userID, profileID)
+	if profile == nil {
+		log.Printf("Failed to update follow of profile %s for user %s: %v", idStr, userID.String(), err)
+		utils.SetFlashMessage(c, utils.FlashError, "Profile not found")
+		c.Redirect(http.StatusFound, "/profiles")
+		return
+	}
+
+	switch {
+	case err == nil:
+		utils.SetFlashMessage(c, utils.FlashSuccess, successMessage)
+	case errors.Is(err, application.ErrSelfFollow), errors.Is(err, application.ErrAlreadyFollowing), errors.Is(err, application.ErrNotFollowing):
+		utils.SetFlashMessage(c, utils.FlashInfo, err.Error())
+	default:
+		log.Printf("Failed to update follow of profile %s for user %s: %v", idStr, userID.String(), err)
+		utils.SetFlashMessage(c, utils.FlashError, "Failed to update follow")
+	}
+	c.Redirect(http.StatusFound, profile.URL())
+}
+
+// RenderFeedPage renders the activity of the developers the user follows
+func (h *Handler) RenderFeedPage(c *gin.Context) {
+	userIDStr := sessions.Default(c).Get("userID")
+	if userIDStr == nil {
+		utils.SetFlashMessage(c, utils.FlashError, "User not authenticated")
+		c.Redirect(http.StatusFound, "/login")
+		return
+	}
+	userID, err := uuid.Parse(userIDStr.(string))
+	if err != nil {
+		log.Printf("Invalid user ID in session: %v", err)
+		utils.SetFlashMessage(c, utils.FlashError, "Failed to load feed")
+		c.Redirect(http.StatusFound, "/login")
+		return
+	}
+
+	page, err := h.ActivityUseCase.GetFeed(userID, c.Query("cursor"), application.DefaultFeedPageSize)
+	if err != nil {
+		log.Printf("Failed to load feed for user %s: %v", userID.String(), err)
+		utils.SetFlashMessage(c, utils.FlashError, "Failed to load feed")
+		c.Redirect(http.StatusFound, "/account")
+		return
+	}
+
+	data := utils.GetTemplateData(c, true)
+	data.ActivityEvents = page.Events
+	data.NextCursor = page.NextCursor
+	c.HTML(http.StatusOK, "users/feed.html", data)
+}
+
+// GetFeed handles fetching a page of the user's activity feed
+func (h *Handler) GetFeed(c *gin.Context) {
+	userIDStr := sessions.Default(c).Get("userID")
+	if userIDStr == nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "User not authenticated"})
+		return
+	}
+	userID, err := uuid.Parse(userIDStr.(string))
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Invalid user ID in session"})
+		return
+	}
+
+	limit, _ := strconv.Atoi(c.DefaultQuery("limit", strconv.Itoa(application.DefaultFeedPageSize)))
+	page, err := h.ActivityUseCase.GetFeed(userID, c.Query("cursor"), limit)
+	if errors.Is(err, application.ErrInvalidCursor) {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if err != nil {
+		log.Printf("Failed to load feed for user %s: %v", userID.String(), err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to load feed"})
+		return
+	}
+
+	events := make([]gin.H, 0, len(page.Events))
+	for _, event := range page.Events {
+		events = append(events, gin.H{
+			"id":         event.ID,
+			"kind":       event.Kind,
+			"summary":    event.Summary(),
+			"title":      event.Title,
+			"detail":     event.Detail,
+			"url":        event.URL,
+			"created_at": event.CreatedAt.Format(time.RFC3339),
+			"actor": gin.H{
+				"id":            event.Actor.ID,
+				"name":          event.Actor.Name,
+				"username":      event.Actor.Username,
+				"url":           event.Actor.URL(),
+				"profile_image": event.Actor.ProfileImage,
+			},
+		})
+	}
+	c.JSON(http.StatusOK, gin.H{"events": events, "next_cursor": page.NextCursor})
+}
@@ -0,0 +1,343 @@
+package http
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"devsearch-go/internal/application"
+	"devsearch-go/internal/domain"
+	"devsearch-go/internal/infrastructure/utils"
+
+	"github.com/gin-contrib/sessions"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// RenderJobPostingsPage renders the public job posting listing with search
+func (h *Handler) RenderJobPostingsPage(c *gin.Context) {
+	isAuthenticated := sessions.Default(c).Get("userID") != nil
+
+	filter := jobPostingFilterFromQuery(c)
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+	limit := 6
+
+	postings, totalPostings, err := h.JobPostingUseCase.GetJobPostings(filter, page, limit)
+	if err != nil {
+		log.Printf("Error fetching job postings: %v", err)
+		utils.SetFlashMessage(c, utils.FlashError, "Failed to load job postings")
+		c.Redirect(http.StatusFound, "/")
+		return
+	}
+
+	data := utils.GetTemplateData(c, isAuthenticated)
+	data.JobPostings = postings
+	data.JobPostingFilter = filter
+	data.Pagination = utils.Paginate(c, int(totalPostings), limit)
+	c.HTML(http.StatusOK, "jobs/jobs.html", data)
+}
+
+// RenderJobPostingPage renders a job posting. Its author sees the best matching developers,
+// other members see how well their own profile matches
+func (h *Handler) RenderJobPostingPage(c *gin.Context) {
+	postingID, ok := jobPostingIDParam(c)
+	if !ok {
+		return
+	}
+
+	posting, err := h.JobPostingUseCase.GetJobPosting(postingID)
+	if err != nil {
+		log.Printf("Job posting not found for ID %s: %v", postingID.String(), err)
+		utils.SetFlashMessage(c, utils.FlashError, "Job posting not found")
+		c.Redirect(http.StatusFound, "/jobs")
+		return
+	}
+
+	userID := viewerID(c)
+	data := utils.GetTemplateData(c, userID != uuid.Nil)
+	data.JobPosting = *posting
+	if userID != uuid.Nil {
+		data.IsOwner = posting.Owner.UserID == userID
+		if data.IsOwner {
+			if data.ProfileMatches, err = h.JobPostingUseCase.RankProfilesForPosting(userID, posting.ID, application.DefaultJobMatchLimit); err != nil {
+				log.Printf("Failed to rank profiles for job posting %s: %v", posting.ID.String(), err)
+			}
+		} else if data.JobMatch, err = h.JobPostingUseCase.GetJobMatch(userID, posting); err != nil {
+			log.Printf("Failed to score job posting %s for user %s: %v", posting.ID.String(), userID.String(), err)
+		}
+	}
+	c.HTML(http.StatusOK, "jobs/job.html", data)
+}
+
+// RenderCreateJobPostingPage renders the create job posting page
+func (h *Handler) RenderCreateJobPostingPage(c *gin.Context) {
+	data := utils.GetTemplateData(c, true)
+	data.FormTitle = "Post a Job"
+	c.HTML(http.StatusOK, "jobs/job_form.html", data)
+}
+
+// RenderUpdateJobPostingPage renders the update job posting page
+func (h *Handler) RenderUpdateJobPostingPage(c *gin.Context) {
+	posting, ok := h.loadUserJobPosting(c)
+	if !ok {
+		return
+	}
+
+	data := utils.GetTemplateData(c, true)
+	data.FormTitle = "Update Job Posting"
+	data.JobPosting = *posting
+	c.HTML(http.StatusOK, "jobs/job_form.html", data)
+}
+
+// RenderDeleteJobPostingPage renders the delete job posting page
+func (h *Handler) RenderDeleteJobPostingPage(c *gin.Context) {
+	posting, ok := h.loadUserJobPosting(c)
+	if !ok {
+		return
+	}
+
+	data := utils.GetTemplateData(c, true)
+	data.Object = posting
+	c.HTML(http.StatusOK, "delete.html", data)
+}
+
+// CreateJobPosting handles publishing a job posting
+func (h *Handler) CreateJobPosting(c *gin.Context) {
+	userID, ok := sessionUserID(c, "Failed to create job posting")
+	if !ok {
+		return
+	}
+
+	posting := jobPostingFromForm(c)
+	if err := h.JobPostingUseCase.CreateJobPosting(userID, posting, strings.Split(c.PostForm("skills"), ",")); err != nil {
+		log.Printf("Failed to create job posting for user %s: %v", userID.String(), err)
+		utils.SetFlashMessage(c, utils.FlashError, err.Error())
+		c.Redirect(http.StatusFound, "/create-job")
+		return
+	}
+
+	utils.SetFlashMessage(c, utils.FlashSuccess, "Job posting was published!")
+	c.Redirect(http.StatusFound, posting.URL())
+}
+
+// UpdateJobPosting handles updating a job posting
+func (h *Handler) UpdateJobPosting(c *gin.Context) {
+	userID, ok := sessionUserID(c, "Failed to update job posting")
+	if !ok {
+		return
+	}
+	postingID, ok := jobPostingIDParam(c)
+	if !ok {
+		return
+	}
+
+	posting, err := h.JobPostingUseCase.UpdateJobPosting(postingID, userID, jobPostingFromForm(c), strings.Split(c.PostForm("skills"), ","))
+	if err != nil {
+		log.Printf("Failed to update job posting %s for user %s: %v", postingID.String(), userID.String(), err)
+		utils.SetFlashMessage(c, utils.FlashError, err.Error())
+		c.Redirect(http.StatusFound, fmt.Sprintf("/update-job/%s", postingID.String()))
+		return
+	}
+
+	utils.SetFlashMessage(c, utils.FlashSuccess, "Job posting was updated successfully!")
+	c.Redirect(http.StatusFound, posting.URL())
+}
+
+// DeleteJobPosting handles deleting a job posting
+func (h *Handler) DeleteJobPosting(c *gin.Context) {
+	userID, ok := sessionUserID(c, "Failed to delete job posting")
+	if !ok {
+		return
+	}
+	postingID, ok := jobPostingIDParam(c)
+	if !ok {
+		return
+	}
+
+	if err := h.JobPostingUseCase.DeleteJobPosting(postingID, userID); err != nil {
+		log.Printf("Failed to delete job posting %s for user %s: %v", postingID.String(), userID.String(), err)
+		utils.SetFlashMessage(c, utils.FlashError, err.Error())
+		c.Redirect(http.StatusFound, "/account")
+		return
+	}
+
+	utils.SetFlashMessage(c, utils.FlashSuccess, "Job posting was deleted successfully!")
+	c.Redirect(http.StatusFound, "/account")
+}
+
+// GetJobPostings handles fetching the public job posting listing
+func (h *Handler) GetJobPostings(c *gin.Context) {
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+	limit := 10
+
+	postings, _, err := h.JobPostingUseCase.GetJobPostings(jobPostingFilterFromQuery(c), page, limit)
+	if err != nil {
+		log.Printf("Error fetching job postings: %v", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to fetch job postings"})
+		return
+	}
+
+	response := make([]gin.H, 0, len(postings))
+	for _, posting := range postings {
+		response = append(response, jobPostingJSON(posting))
+	}
+	c.JSON(http.StatusOK, response)
+}
+
+// GetJobPosting handles fetching a single job posting by ID
+func (h *Handler) GetJobPosting(c *gin.Context) {
+	postingID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid job posting ID"})
+		return
+	}
+
+	posting, err := h.JobPostingUseCase.GetJobPosting(postingID)
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "Job posting not found"})
+		return
+	}
+	c.JSON(http.StatusOK, jobPostingJSON(*posting))
+}
+
+// GetJobPostingMatches handles ranking developers for one of the user's job postings
+func (h *Handler) GetJobPostingMatches(c *gin.Context) {
+	userID := viewerID(c)
+	if userID == uuid.Nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "User not authenticated"})
+		return
+	}
+	postingID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid job posting ID"})
+		return
+	}
+
+	matches, err := h.JobPostingUseCase.RankProfilesForPosting(userID, postingID, application.DefaultJobMatchLimit)
+	if err != nil {
+		switch {
+		case errors.Is(err, application.ErrJobPostingNotFound):
+			c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		case errors.Is(err, application.ErrJobPostingNotOwned):
+			c.JSON(http.StatusForbidden, gin.H{"error": err.Error()})
+		default:
+			log.Printf("Failed to rank profiles for job posting %s: %v", postingID.String(), err)
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to rank developers"})
+		}
+		return
+	}
+
+	response := make([]gin.H, 0, len(matches))
+	for _, match := range matches {
+		response = append(response, gin.H{
+			"profile_id":     match.Profile.ID,
+			"name":           match.Profile.Name,
+			"url":            match.Profile.URL(),
+			"score":          match.Score,
+			"matched_skills": match.MatchedSkills,
+			"missing_skills": match.MissingSkills,
+		})
+	}
+	c.JSON(http.StatusOK, response)
+}
+
+// GetJobMatches handles ranking job postings for the user's profile
+func (h *Handler) GetJobMatches(c *gin.Context) {
+	userID := viewerID(c)
+	if userID == uuid.Nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "User not authenticated"})
+		return
+	}
+
+	matches, err := h.JobPostingUseCase.RankPostingsForProfile(userID, application.DefaultJobMatchLimit)
+	if err != nil {
+		log.Printf("Failed to load job matches for user %s: %v", userID.String(), err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to load job matches"})
+		return
+	}
+
+	response := make([]gin.H, 0, len(matches))
+	for _, match := range matches {
+		posting := jobPostingJSON(match.Posting)
+		posting["score"] = match.Score
+		posting["matched_skills"] = match.MatchedSkills
+		posting["missing_skills"] = match.MissingSkills
+		response = append(response, posting)
+	}
+	c.JSON(http.StatusOK, response)
+}
+
+// loadUserJobPosting loads the job posting named by the route for the authenticated user,
+// redirecting to the account page when it cannot be found.
+func (h *Handler) loadUserJobPosting(c *gin.Context) (*domain.JobPosting, bool) {
+	userID, ok := sessionUserID(c, "Failed to load job posting")
+	if !ok {
+		return nil, false
+	}
+	postingID, ok := jobPostingIDParam(c)
+	if !ok {
+		return nil, false
+	}
+
+	posting, err := h.JobPostingUseCase.GetUserJobPosting(postingID, userID)
+	if err != nil {
+		log.Printf("Job posting %s not found for user %s: %v", postingID.String(), userID.String(), err)
+		utils.SetFlashMessage(c, utils.FlashError, "Job posting not found")
+		c.Redirect(http.StatusFound, "/account")
+		return nil, false
+	}
+	return posting, true
+}
+
+// jobPostingIDParam parses the job posting ID route parameter, redirecting to the job listing when it is invalid.
+func jobPostingIDParam(c *gin.Context) (uuid.UUID, bool) {
+	postingID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.SetFlashMessage(c, utils.FlashError, "Invalid job posting ID")
+		c.Redirect(http.StatusFound, "/jobs")
+		return uuid.Nil, false
+	}
+	return postingID, true
+}
+
+// jobPostingFilterFromQuery reads the job listing search from the query string.
+func jobPostingFilterFromQuery(c *gin.Context) application.JobPostingFilter {
+	return application.JobPostingFilter{
+		Query:      c.Query("search_query"),
+		Location:   c.Query("location"),
+		RemoteOnly: c.Query("remote") == "on",
+	}
+}
+
+// jobPostingFromForm builds a job posting from the submitted form fields.
+func jobPostingFromForm(c *gin.Context) *domain.JobPosting {
+	return &domain.JobPosting{
+		Company:     strings.TrimSpace(c.PostForm("company")),
+		Title:       strings.TrimSpace(c.PostForm("title")),
+		Location:    strings.TrimSpace(c.PostForm("location")),
+		Remote:      c.PostForm("remote") == "on",
+		Description: c.PostForm("description"),
+	}
+}
+
+// jobPostingJSON describes a job posting for the API without its author's private profile fields.
+func jobPostingJSON(posting domain.JobPosting) gin.H {
+	skills := make([]string, 0, len(posting.RequiredSkills))
+	for _, skill := range posting.RequiredSkills {
+		skills = append(skills, skill.Name)
+	}
+	return gin.H{
+		"id":              posting.ID,
+		"company":         posting.Company,
+		"title":           posting.Title,
+		"location":        posting.Location,
+		"remote":          posting.Remote,
+		"description":     posting.Description,
+		"required_skills": skills,
+		"posted_by":       posting.OwnerID,
+		"created_at":      posting.CreatedAt,
+	}
+}
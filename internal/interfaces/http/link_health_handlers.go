@@ -0,0 +1,29 @@
+package http
+
+import (
+	"log"
+	"net/http"
+
+	"devsearch-go/internal/infrastructure/utils"
+
+	"github.com/gin-gonic/gin"
+)
+
+// RenderLinkHealthPage renders the status and check history of the user's demo, source and social links
+func (h *Handler) RenderLinkHealthPage(c *gin.Context) {
+	userID, ok := sessionUserID(c, "Failed to load links")
+	if !ok {
+		return
+	}
+	entries, err := h.LinkHealthUseCase.GetLinkHealth(userID)
+	if err != nil {
+		log.Printf("Failed to load link health for user %s: %v", userID.String(), err)
+		utils.SetFlashMessage(c, utils.FlashError, "Failed to load links")
+		c.Redirect(http.StatusFound, "/account")
+		return
+	}
+
+	data := utils.GetTemplateData(c, true)
+	data.LinkHealth = entries
+	c.HTML(http.StatusOK, "users/link_health.html", data)
+}
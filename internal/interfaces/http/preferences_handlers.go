@@ -0,0 +1,114 @@
+package http
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"devsearch-go/internal/domain"
+	"devsearch-go/internal/infrastructure/utils"
+
+	"github.com/gin-contrib/sessions"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// RenderJobPreferencesPage renders the availability and job preferences form
+func (h *Handler) RenderJobPreferencesPage(c *gin.Context) {
+	userIDStr := sessions.Default(c).Get("userID")
+	if userIDStr == nil {
+		utils.SetFlashMessage(c, utils.FlashError, "User not authenticated")
+		c.Redirect(http.StatusFound, "/login")
+		return
+	}
+	userID, err := uuid.Parse(userIDStr.(string))
+	if err != nil {
+		log.Printf("Invalid user ID in session: %v", err)
+		utils.SetFlashMessage(c, utils.FlashError, "Failed to get user account")
+		c.Redirect(http.StatusFound, "/login")
+		return
+	}
+
+	profile, err := h.UserUseCase.GetProfileByUserID(userID)
+	if err != nil {
+		log.Printf("Profile not found for user %s: %v", userID.String(), err)
+		utils.SetFlashMessage(c, utils.FlashError, "Profile not found")
+		c.Redirect(http.StatusFound, "/account")
+		return
+	}
+
+	data := utils.GetTemplateData(c, true)
+	data.FormTitle = "Job Preferences"
+	data.Profile = *profile
+	c.HTML(http.StatusOK, "users/preferences_form.html", data)
+}
+
+// UpdateJobPreferences handles updating the authenticated user's availability and job preferences
+func (h *Handler) UpdateJobPreferences(c *gin.Context) {
+	userIDStr := sessions.Default(c).Get("userID")
+	if userIDStr == nil {
+		utils.SetFlashMessage(c, utils.FlashError, "User not authenticated")
+		c.Redirect(http.StatusFound, "/login")
+		return
+	}
+	userID, err := uuid.Parse(userIDStr.(string))
+	if err != nil {
+		log.Printf("Invalid user ID in session: %v", err)
+		utils.SetFlashMessage(c, utils.FlashError, "Failed to update job preferences")
+		c.Redirect(http.StatusFound, "/login")
+		return
+	}
+
+	preferences, err := jobPreferencesFromForm(c)
+	if err != nil {
+		utils.SetFlashMessage(c, utils.FlashError, err.Error())
+		c.Redirect(http.StatusFound, "/edit-preferences")
+		return
+	}
+
+	if _, err := h.UserUseCase.UpdateJobPreferences(userID, preferences); err != nil {
+		log.Printf("Failed to update job preferences for user %s: %v", userID.String(), err)
+		utils.SetFlashMessage(c, utils.FlashError, err.Error())
+		c.Redirect(http.StatusFound, "/edit-preferences")
+		return
+	}
+
+	utils.SetFlashMessage(c, utils.FlashSuccess, "Job preferences were updated successfully!")
+	c.Redirect(http.StatusFound, "/account")
+}
+
+// jobPreferencesFromForm reads the fields of the job preferences form.
+func jobPreferencesFromForm(c *gin.Context) (domain.JobPreferences, error) {
+	preferences := domain.JobPreferences{
+		Availability:     c.PostForm("availability"),
+		PreferredRoles:   c.PostForm("preferred_roles"),
+		WorkArrangement:  c.PostForm("work_arrangement"),
+		SalaryCurrency:   c.PostForm("salary_currency"),
+		SalaryVisibility: c.PostForm("salary_visibility"),
+		TimeZone:         c.PostForm("time_zone"),
+	}
+
+	var err error
+	if preferences.SalaryMin, err = formInt(c, "salary_min"); err != nil {
+		return preferences, err
+	}
+	if preferences.SalaryMax, err = formInt(c, "salary_max"); err != nil {
+		return preferences, err
+	}
+	return preferences, nil
+}
+
+// formInt reads an optional whole number form field.
+func formInt(c *gin.Context, field string) (int, error) {
+	value := strings.TrimSpace(c.PostForm(field))
+	if value == "" {
+		return 0, nil
+	}
+	number, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, fmt.Errorf("%s must be a whole number", strings.ReplaceAll(field, "_", " "))
+	}
+	return number, nil
+}
@@ -0,0 +1,98 @@
+package http
+
+import (
+	"log"
+	"net/http"
+
+	"devsearch-go/internal/application"
+	"devsearch-go/internal/domain"
+	"devsearch-go/internal/infrastructure/utils"
+
+	"github.com/gin-contrib/sessions"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// RenderPrivacyPage renders the profile privacy settings form
+func (h *Handler) RenderPrivacyPage(c *gin.Context) {
+	userIDStr := sessions.Default(c).Get("userID")
+	if userIDStr == nil {
+		utils.SetFlashMessage(c, utils.FlashError, "User not authenticated")
+		c.Redirect(http.StatusFound, "/login")
+		return
+	}
+	userID, err := uuid.Parse(userIDStr.(string))
+	if err != nil {
+		log.Printf("Invalid user ID in session: %v", err)
+		utils.SetFlashMessage(c, utils.FlashError, "Failed to get user account")
+		c.Redirect(http.StatusFound, "/login")
+		return
+	}
+
+	profile, err := h.UserUseCase.GetProfileByUserID(userID)
+	if err != nil {
+		log.Printf("Profile not found for user %s: %v", userID.String(), err)
+		utils.SetFlashMessage(c, utils.FlashError, "Profile not found")
+		c.Redirect(http.StatusFound, "/account")
+		return
+	}
+
+	data := utils.GetTemplateData(c, true)
+	data.FormTitle = "Privacy Settings"
+	data.Profile = *profile
+	c.HTML(http.StatusOK, "users/privacy_form.html", data)
+}
+
+// UpdatePrivacy handles updating the authenticated user's profile privacy settings
+func (h *Handler) UpdatePrivacy(c *gin.Context) {
+	userIDStr := sessions.Default(c).Get("userID")
+	if userIDStr == nil {
+		utils.SetFlashMessage(c, utils.FlashError, "User not authenticated")
+		c.Redirect(http.StatusFound, "/login")
+		return
+	}
+	userID, err := uuid.Parse(userIDStr.(string))
+	if err != nil {
+		log.Printf("Invalid user ID in session: %v", err)
+		utils.SetFlashMessage(c, utils.FlashError, "Failed to update privacy settings")
+		c.Redirect(http.StatusFound, "/login")
+		return
+	}
+
+	privacy := domain.ProfilePrivacy{
+		Mode:        c.PostForm("mode"),
+		Email:       c.PostForm("email"),
+		Location:    c.PostForm("location"),
+		SocialLinks: c.PostForm("social_links"),
+		Experiences: c.PostForm("experiences"),
+		Educations:  c.PostForm("educations"),
+	}
+	if _, err := h.UserUseCase.UpdatePrivacy(userID, privacy); err != nil {
+		log.Printf("Failed to update privacy settings for user %s: %v", userID.String(), err)
+		utils.SetFlashMessage(c, utils.FlashError, err.Error())
+		c.Redirect(http.StatusFound, "/edit-privacy")
+		return
+	}
+
+	utils.SetFlashMessage(c, utils.FlashSuccess, "Privacy settings were updated successfully!")
+	c.Redirect(http.StatusFound, "/account")
+}
+
+// viewerID returns the ID of the logged-in user, or uuid.Nil for anonymous visitors.
+func viewerID(c *gin.Context) uuid.UUID {
+	userIDStr, ok := sessions.Default(c).Get("userID").(string)
+	if !ok {
+		return uuid.Nil
+	}
+	userID, err := uuid.Parse(userIDStr)
+	if err != nil {
+		return uuid.Nil
+	}
+	return userID
+}
+
+// viewerPrincipal returns the principal for the current visitor, with the role the ActiveUser
+// middleware recorded for signed-in users.
+func viewerPrincipal(c *gin.Context) application.Principal {
+	return application.Principal{UserID: viewerID(c), Role: c.GetString("userRole")}
+}
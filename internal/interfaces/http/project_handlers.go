@@ -1,6 +1,7 @@
 package http
 
 import (
+	"errors"
 	"fmt"
 	"io"
 	"log"
@@ -8,6 +9,7 @@ import (
 	"os"
 	"path/filepath"
 	"strings"
+	"time"
 
 	"devsearch-go/internal/application"
 	"devsearch-go/internal/domain"
@@ -19,8 +21,25 @@ import (
 )
 
 type Handler struct {
-	ProjectUseCase *application.ProjectUseCase
-	UserUseCase    *application.UserUseCase // Added for user-related operations
+	ProjectUseCase        *application.ProjectUseCase
+	UserUseCase           *application.UserUseCase // Added for user-related operations
+	ResumeUseCase         *application.ResumeUseCase
+	CareerUseCase         *application.CareerUseCase
+	SkillCatalogUseCase   *application.SkillCatalogUseCase
+	EndorsementUseCase    *application.EndorsementUseCase
+	AnalyticsUseCase      *application.AnalyticsUseCase
+	ActivityUseCase       *application.ActivityUseCase
+	ShortlistUseCase      *application.ShortlistUseCase
+	SavedSearchUseCase    *application.SavedSearchUseCase
+	JobPostingUseCase     *application.JobPostingUseCase
+	RecommendationUseCase *application.RecommendationUseCase
+	CollaboratorUseCase   *application.CollaboratorUseCase
+	AdminUseCase          *application.AdminUseCase
+	ReportUseCase         *application.ReportUseCase
+	AuditUseCase          *application.AuditUseCase
+	TrashUseCase          *application.TrashUseCase
+	ProjectImportUseCase  *application.ProjectImportUseCase
+	LinkHealthUseCase     *application.LinkHealthUseCase
 }
 
 // GetProjects handles fetching all projects
@@ -84,14 +103,6 @@ func (h *Handler) CreateProject(c *gin.Context) {
 		return
 	}
 
-	profile, err := h.UserUseCase.GetProfileByID(userID)
-	if err != nil {
-		log.Printf("Profile not found for authenticated user %s: %v", userID.String(), err)
-		utils.SetFlashMessage(c, utils.FlashError, "Profile not found for authenticated user")
-		c.Redirect(http.StatusFound, "/login")
-		return
-	}
-
 	title := c.PostForm("title")
 	description := c.PostForm("description")
 	demoLink := c.PostForm("demo_link")
@@ -99,12 +110,17 @@ func (h *Handler) CreateProject(c *gin.Context) {
 	tagsStr := c.PostForm("tags")
 
 	project := domain.Project{
-		OwnerID:     profile.ID,
 		Title:       title,
+		Slug:        c.PostForm("slug"),
 		Description: description,
 		DemoLink:    demoLink,
 		SourceLink:  sourceLink,
 	}
+	if err := projectStatusFromForm(c, &project); err != nil {
+		utils.SetFlashMessage(c, utils.FlashError, err.Error())
+		c.Redirect(http.StatusFound, "/create-project")
+		return
+	}
 
 	// Handle featured image upload
 	file, err := c.FormFile("featured_image")
@@ -149,7 +165,13 @@ func (h *Handler) CreateProject(c *gin.Context) {
 	}
 
 	tagNames := strings.Split(tagsStr, ",")
-	if err := h.ProjectUseCase.CreateProject(&project, tagNames); err != nil {
+	if err := h.ProjectUseCase.CreateProject(userID, &project, tagNames, requestMeta(c)); err != nil {
+		if errors.Is(err, application.ErrInvalidProjectStatus) || errors.Is(err, application.ErrPublishTimeInPast) ||
+			errors.Is(err, application.ErrInvalidLink) {
+			utils.SetFlashMessage(c, utils.FlashError, err.Error())
+			c.Redirect(http.StatusFound, "/create-project")
+			return
+		}
 		log.Printf("Failed to create project for user %s: %v", userID.String(), err)
 		utils.SetFlashMessage(c, utils.FlashError, "Failed to create project")
 		c.Redirect(http.StatusFound, "/create-project")
@@ -160,10 +182,9 @@ func (h *Handler) CreateProject(c *gin.Context) {
 	c.Redirect(http.StatusFound, "/account")
 }
 
-// UpdateProject handles updating an existing project
-func (h *Handler) UpdateProject(c *gin.Context) {
-	session := sessions.Default(c)
-	userIDStr := session.Get("userID")
+// CreateReview handles reviewing and voting on another developer's project
+func (h *Handler) CreateReview(c *gin.Context) {
+	userIDStr := sessions.Default(c).Get("userID")
 	if userIDStr == nil {
 		utils.SetFlashMessage(c, utils.FlashError, "User not authenticated")
 		c.Redirect(http.StatusFound, "/login")
@@ -172,8 +193,8 @@ func (h *Handler) UpdateProject(c *gin.Context) {
 	userID, err := uuid.Parse(userIDStr.(string))
 	if err != nil {
 		log.Printf("Invalid user ID in session: %v", err)
-		utils.SetFlashMessage(c, utils.FlashError, "Failed to update project")
-		c.Redirect(http.StatusFound, "/account")
+		utils.SetFlashMessage(c, utils.FlashError, "Failed to submit review")
+		c.Redirect(http.StatusFound, "/login")
 		return
 	}
 
@@ -185,26 +206,53 @@ func (h *Handler) UpdateProject(c *gin.Context) {
 		return
 	}
 
-	project, err := h.ProjectUseCase.GetProjectByID(id)
-	if err != nil {
-		log.Printf("Project not found or unauthorized for user %s, project %s: %v", userID.String(), idStr, err)
-		utils.SetFlashMessage(c, utils.FlashError, "Project not found or you don't have permission")
+	// The project is only nil when it could not be loaded.
+	project, err := h.ProjectUseCase.AddReview(id, userID, c.PostForm("body"), c.PostForm("value"))
+	if project == nil {
+		log.Printf("Project not found for ID %s: %v", idStr, err)
+		utils.SetFlashMessage(c, utils.FlashError, "Project not found")
 		c.Redirect(http.StatusFound, "/projects")
 		return
 	}
 
-	// Ensure the authenticated user is the owner of the project
-	profile, err := h.UserUseCase.GetProfileByID(userID)
-	if err != nil || project.OwnerID != profile.ID {
-		utils.SetFlashMessage(c, utils.FlashError, "You don't have permission to edit this project")
+	switch {
+	case err == nil:
+		utils.SetFlashMessage(c, utils.FlashSuccess, "Your review was successfully submitted!")
+	case errors.Is(err, application.ErrOwnProjectReview), errors.Is(err, application.ErrAlreadyReviewed), errors.Is(err, application.ErrInvalidVote):
+		utils.SetFlashMessage(c, utils.FlashInfo, err.Error())
+	default:
+		log.Printf("Failed to review project %s for user %s: %v", idStr, userID.String(), err)
+		utils.SetFlashMessage(c, utils.FlashError, "Failed to submit review")
+	}
+	c.Redirect(http.StatusFound, project.URL())
+}
+
+// UpdateProject handles updating an existing project
+func (h *Handler) UpdateProject(c *gin.Context) {
+	userID, ok := sessionUserID(c, "Failed to update project")
+	if !ok {
+		return
+	}
+	idStr := c.Param("id")
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		utils.SetFlashMessage(c, utils.FlashError, "Invalid project ID")
 		c.Redirect(http.StatusFound, "/projects")
 		return
 	}
 
-	project.Title = c.PostForm("title")
-	project.Description = c.PostForm("description")
-	project.DemoLink = c.PostForm("demo_link")
-	project.SourceLink = c.PostForm("source_link")
+	project := domain.Project{
+		Title:       c.PostForm("title"),
+		Slug:        c.PostForm("slug"),
+		Description: c.PostForm("description"),
+		DemoLink:    c.PostForm("demo_link"),
+		SourceLink:  c.PostForm("source_link"),
+	}
+	if err := projectStatusFromForm(c, &project); err != nil {
+		utils.SetFlashMessage(c, utils.FlashError, err.Error())
+		c.Redirect(http.StatusFound, fmt.Sprintf("/update-project/%s", idStr))
+		return
+	}
 	tagsStr := c.PostForm("tags")
 
 	// Handle featured image upload
@@ -249,7 +297,16 @@ func (h *Handler) UpdateProject(c *gin.Context) {
 	}
 
 	tagNames := strings.Split(tagsStr, ",")
-	if err := h.ProjectUseCase.UpdateProject(project, tagNames); err != nil {
+	if _, err := h.ProjectUseCase.UpdateProject(id, userID, &project, tagNames, requestMeta(c)); err != nil {
+		if h.projectAccessDenied(c, id, err) {
+			return
+		}
+		if errors.Is(err, application.ErrInvalidProjectStatus) || errors.Is(err, application.ErrPublishTimeInPast) ||
+			errors.Is(err, application.ErrInvalidLink) {
+			utils.SetFlashMessage(c, utils.FlashError, err.Error())
+			c.Redirect(http.StatusFound, fmt.Sprintf("/update-project/%s", idStr))
+			return
+		}
 		log.Printf("Failed to update project %s for user %s: %v", idStr, userID.String(), err)
 		utils.SetFlashMessage(c, utils.FlashError, "Failed to update project")
 		c.Redirect(http.StatusFound, fmt.Sprintf("/update-project/%s", idStr))
@@ -262,52 +319,115 @@ func (h *Handler) UpdateProject(c *gin.Context) {
 
 // DeleteProject handles deleting a project
 func (h *Handler) DeleteProject(c *gin.Context) {
-	session := sessions.Default(c)
-	userIDStr := session.Get("userID")
-	if userIDStr == nil {
-		utils.SetFlashMessage(c, utils.FlashError, "User not authenticated")
-		c.Redirect(http.StatusFound, "/login")
+	userID, ok := sessionUserID(c, "Failed to delete project")
+	if !ok {
 		return
 	}
-	userID, err := uuid.Parse(userIDStr.(string))
+	idStr := c.Param("id")
+	id, err := uuid.Parse(idStr)
 	if err != nil {
-		log.Printf("Invalid user ID in session: %v", err)
+		utils.SetFlashMessage(c, utils.FlashError, "Invalid project ID")
+		c.Redirect(http.StatusFound, "/projects")
+		return
+	}
+
+	if err := h.ProjectUseCase.DeleteProject(id, userID, requestMeta(c)); err != nil {
+		if h.projectAccessDenied(c, id, err) {
+			return
+		}
+		log.Printf("Failed to delete project %s for user %s: %v", idStr, userID.String(), err)
 		utils.SetFlashMessage(c, utils.FlashError, "Failed to delete project")
 		c.Redirect(http.StatusFound, "/account")
 		return
 	}
 
-	idStr := c.Param("id")
-	id, err := uuid.Parse(idStr)
+	utils.SetFlashMessage(c, utils.FlashSuccess, "Project moved to the trash. You can restore it from your account page.")
+	c.Redirect(http.StatusFound, "/account")
+}
+
+// RevertProject handles restoring an earlier revision of a project
+func (h *Handler) RevertProject(c *gin.Context) {
+	userID, ok := sessionUserID(c, "Failed to revert project")
+	if !ok {
+		return
+	}
+	projectID, err := uuid.Parse(c.Param("id"))
 	if err != nil {
 		utils.SetFlashMessage(c, utils.FlashError, "Invalid project ID")
 		c.Redirect(http.StatusFound, "/projects")
 		return
 	}
-
-	project, err := h.ProjectUseCase.GetProjectByID(id)
+	revisionID, err := uuid.Parse(c.Param("revisionID"))
 	if err != nil {
-		log.Printf("Project not found or unauthorized for user %s, project %s: %v", userID.String(), idStr, err)
-		utils.SetFlashMessage(c, utils.FlashError, "Project not found or you don't have permission")
-		c.Redirect(http.StatusFound, "/account")
+		utils.SetFlashMessage(c, utils.FlashError, "Invalid revision ID")
+		c.Redirect(http.StatusFound, "/project/"+projectID.String())
 		return
 	}
 
-	// Ensure the authenticated user is the owner of the project
-	profile, err := h.UserUseCase.GetProfileByID(userID)
-	if err != nil || project.OwnerID != profile.ID {
-		utils.SetFlashMessage(c, utils.FlashError, "You don't have permission to delete this project")
-		c.Redirect(http.StatusFound, "/projects")
+	project, err := h.ProjectUseCase.RevertProject(projectID, revisionID, userID, requestMeta(c))
+	switch {
+	case err == nil:
+		utils.SetFlashMessage(c, utils.FlashSuccess, "Project reverted to the earlier revision")
+	case h.projectAccessDenied(c, projectID, err):
 		return
+	case errors.Is(err, application.ErrRevisionNotFound):
+		utils.SetFlashMessage(c, utils.FlashError, "Revision not found")
+	default:
+		log.Printf("Failed to revert project %s to revision %s for user %s: %v", projectID.String(), revisionID.String(), userID.String(), err)
+		utils.SetFlashMessage(c, utils.FlashError, "Failed to revert project")
 	}
+	c.Redirect(http.StatusFound, project.URL())
+}
 
-	if err := h.ProjectUseCase.DeleteProject(id); err != nil {
-		log.Printf("Failed to delete project %s for user %s: %v", idStr, userID.String(), err)
-		utils.SetFlashMessage(c, utils.FlashError, "Failed to delete project")
-		c.Redirect(http.StatusFound, "/account")
-		return
+// dateTimeInputLayout is the value format of <input type="datetime-local"> fields.
+const dateTimeInputLayout = "2006-01-02T15:04"
+
+// projectStatusFromForm reads the status of a project form and, for drafts, the time to
+// publish them at. The time is entered in the server's time zone.
+func projectStatusFromForm(c *gin.Context, project *domain.Project) error {
+	project.Status = c.PostForm("status")
+	publishAt := c.PostForm("publish_at")
+	if publishAt == "" || project.Status != domain.ProjectStatusDraft {
+		return nil
+	}
+	scheduled, err := time.ParseInLocation(dateTimeInputLayout, publishAt, time.Local)
+	if err != nil {
+		return fmt.Errorf("invalid publication time")
 	}
+	project.PublishAt = &scheduled
+	return nil
+}
 
-	utils.SetFlashMessage(c, utils.FlashSuccess, "Project deleted successfully!")
-	c.Redirect(http.StatusFound, "/account")
+// loadAuthorizedProject loads the project named by the ID route parameter when the user may
+// perform the action on it, redirecting with a flash message otherwise.
+func (h *Handler) loadAuthorizedProject(c *gin.Context, userID uuid.UUID, action application.Action) (*domain.Project, bool) {
+	projectID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.SetFlashMessage(c, utils.FlashError, "Invalid project ID")
+		c.Redirect(http.StatusFound, "/projects")
+		return nil, false
+	}
+
+	project, err := h.ProjectUseCase.AuthorizeProject(projectID, userID, action)
+	if err != nil {
+		h.projectAccessDenied(c, projectID, err)
+		return nil, false
+	}
+	return project, true
+}
+
+// projectAccessDenied redirects with a flash message when err says the project does not exist
+// or the user may not change it, and reports whether it did.
+func (h *Handler) projectAccessDenied(c *gin.Context, projectID uuid.UUID, err error) bool {
+	switch {
+	case errors.Is(err, application.ErrPermissionDenied):
+		utils.SetFlashMessage(c, utils.FlashError, "You don't have permission to change this project")
+		c.Redirect(http.StatusFound, "/project/"+projectID.String())
+		return true
+	case errors.Is(err, application.ErrProjectNotFound):
+		utils.SetFlashMessage(c, utils.FlashError, "Project not found")
+		c.Redirect(http.StatusFound, "/projects")
+		return true
+	}
+	return false
 }
@@ -0,0 +1,79 @@
+package http
+
+import (
+	"errors"
+	"log"
+	"net/http"
+	"strings"
+
+	"devsearch-go/internal/application"
+	"devsearch-go/internal/domain"
+	"devsearch-go/internal/infrastructure/utils"
+
+	"github.com/gin-gonic/gin"
+)
+
+// maxRepositoryArchiveSize limits the size of uploaded repository archives.
+const maxRepositoryArchiveSize = 50 << 20
+
+// RenderImportProjectPage renders the page for importing a project from a repository
+func (h *Handler) RenderImportProjectPage(c *gin.Context) {
+	data := utils.GetTemplateData(c, true)
+	data.FormTitle = "Import Project"
+	c.HTML(http.StatusOK, "project-import.html", data)
+}
+
+// ImportProject handles prefilling the project form from an uploaded repository archive or a
+// repository on the local git mirror
+func (h *Handler) ImportProject(c *gin.Context) {
+	userID, ok := sessionUserID(c, "Failed to import project")
+	if !ok {
+		return
+	}
+
+	var result *application.ProjectImport
+	var err error
+	if file, fileErr := c.FormFile("repository_archive"); fileErr == nil {
+		if file.Size > maxRepositoryArchiveSize {
+			utils.SetFlashMessage(c, utils.FlashError, "Repository archive is too large")
+			c.Redirect(http.StatusFound, "/import-project")
+			return
+		}
+		src, openErr := file.Open()
+		if openErr != nil {
+			log.Printf("Failed to open repository archive: %v", openErr)
+			utils.SetFlashMessage(c, utils.FlashError, "Failed to open repository archive")
+			c.Redirect(http.StatusFound, "/import-project")
+			return
+		}
+		defer src.Close()
+		result, err = h.ProjectImportUseCase.ImportArchive(src, file.Size, file.Filename)
+	} else if mirrorPath := strings.TrimSpace(c.PostForm("mirror_path")); mirrorPath != "" {
+		result, err = h.ProjectImportUseCase.ImportMirror(mirrorPath)
+	} else {
+		utils.SetFlashMessage(c, utils.FlashError, "Please choose a repository archive or enter a mirror path")
+		c.Redirect(http.StatusFound, "/import-project")
+		return
+	}
+
+	switch {
+	case err == nil:
+	case errors.Is(err, application.ErrUnsupportedArchive), errors.Is(err, application.ErrMirrorImportDisabled),
+		errors.Is(err, application.ErrMirrorRepositoryNotFound):
+		utils.SetFlashMessage(c, utils.FlashError, err.Error())
+		c.Redirect(http.StatusFound, "/import-project")
+		return
+	default:
+		log.Printf("Failed to import project for user %s: %v", userID.String(), err)
+		utils.SetFlashMessage(c, utils.FlashError, "Failed to read the repository")
+		c.Redirect(http.StatusFound, "/import-project")
+		return
+	}
+
+	data := utils.GetTemplateData(c, true)
+	data.FormTitle = "Create Project"
+	data.Project = result.Project
+	data.ProjectImport = result
+	data.ProjectStatuses = domain.ProjectStatuses
+	c.HTML(http.StatusOK, "form-template.html", data)
+}
@@ -5,6 +5,8 @@ import (
 	"net/http"
 	"strconv"
 
+	"devsearch-go/internal/application"
+	"devsearch-go/internal/domain"
 	"devsearch-go/internal/infrastructure/utils"
 
 	"github.com/gin-contrib/sessions"
@@ -63,86 +65,111 @@ func (h *Handler) RenderSingleProjectPage(c *gin.Context) {
 		return
 	}
 
-	data := utils.GetTemplateData(c, isAuthenticated)
-	data.Project = *project
-	c.HTML(http.StatusOK, "single-project.html", data)
-}
-
-func (h *Handler) RenderCreateProjectPage(c *gin.Context) {
-	session := sessions.Default(c)
-	userIDStr := session.Get("userID")
-	isAuthenticated := userIDStr != nil
-
-	data := utils.GetTemplateData(c, isAuthenticated)
-	data.FormTitle = "Create Project"
-	c.HTML(http.StatusOK, "form-template.html", data)
+	h.renderProject(c, isAuthenticated, project)
 }
 
-func (h *Handler) RenderUpdateProjectPage(c *gin.Context) {
+// RenderProjectBySlugPage renders a single project addressed by its slug, redirecting old slugs
+func (h *Handler) RenderProjectBySlugPage(c *gin.Context) {
 	session := sessions.Default(c)
 	userIDStr := session.Get("userID")
 	isAuthenticated := userIDStr != nil
 
-	idStr := c.Param("id")
-	id, err := uuid.Parse(idStr)
+	slug := c.Param("slug")
+	project, moved, err := h.ProjectUseCase.GetProjectBySlug(slug)
 	if err != nil {
-		utils.SetFlashMessage(c, utils.FlashError, "Invalid project ID")
+		log.Printf("Project not found for slug %s: %v", slug, err)
+		utils.SetFlashMessage(c, utils.FlashError, "Project not found")
 		c.Redirect(http.StatusFound, "/projects")
 		return
 	}
-
-	project, err := h.ProjectUseCase.GetProjectByID(id)
-	if err != nil {
-		log.Printf("Project not found for ID %s: %v", idStr, err)
-		utils.SetFlashMessage(c, utils.FlashError, "Project not found")
-		c.Redirect(http.StatusFound, "/projects")
+	if moved {
+		c.Redirect(http.StatusMovedPermanently, project.URL())
 		return
 	}
 
-	// Ensure the authenticated user is the owner of the project
-	profile, err := h.UserUseCase.GetProfileByID(uuid.MustParse(userIDStr.(string)))
-	if err != nil || project.OwnerID != profile.ID {
-		utils.SetFlashMessage(c, utils.FlashError, "You don't have permission to edit this project")
+	h.renderProject(c, isAuthenticated, project)
+}
+
+// renderProject renders the project page as seen by the current visitor.
+func (h *Handler) renderProject(c *gin.Context, isAuthenticated bool, project *domain.Project) {
+	principal := viewerPrincipal(c)
+	if !application.CanSeeProject(principal, project) {
+		utils.SetFlashMessage(c, utils.FlashError, "Project not found")
 		c.Redirect(http.StatusFound, "/projects")
 		return
 	}
+	h.recordView(c, domain.ViewKindProject, project.ID, project.OwnerID)
 
+	currentUserID := principal.UserID
 	data := utils.GetTemplateData(c, isAuthenticated)
-	data.FormTitle = "Update Project"
 	data.Project = *project
-	c.HTML(http.StatusOK, "form-template.html", data)
+	data.CurrentUserID = currentUserID
+	data.IsOwner = currentUserID != uuid.Nil && currentUserID == project.OwnerID
+	data.CanEditProject = application.Can(principal, application.ActionEdit, project)
+	data.CanDeleteProject = application.Can(principal, application.ActionDelete, project)
+	data.CanManageCollaborators = application.Can(principal, application.ActionManageCollaborators, project)
+	data.CanRevertProject = application.Can(principal, application.ActionRevert, project)
+	data.CollaboratorRoles = domain.CollaboratorRoles
+	data.ReportReasons = domain.ReportReasons
+	for _, review := range project.Reviews {
+		if review.OwnerID == currentUserID {
+			data.HasReviewed = true
+		}
+	}
+	related, err := h.RecommendationUseCase.GetRelatedProjects(project.ID, application.DefaultRecommendationLimit)
+	if err != nil {
+		log.Printf("Failed to load related projects of project %s: %v", project.ID.String(), err)
+	}
+	data.RelatedProjects = related
+	history, err := h.ProjectUseCase.GetProjectHistory(project.ID)
+	if err != nil {
+		log.Printf("Failed to load history of project %s: %v", project.ID.String(), err)
+	}
+	data.ProjectHistory = history
+	c.HTML(http.StatusOK, "single-project.html", data)
 }
 
-func (h *Handler) RenderDeleteProjectPage(c *gin.Context) {
+func (h *Handler) RenderCreateProjectPage(c *gin.Context) {
 	session := sessions.Default(c)
 	userIDStr := session.Get("userID")
 	isAuthenticated := userIDStr != nil
 
-	idStr := c.Param("id")
-	id, err := uuid.Parse(idStr)
-	if err != nil {
-		utils.SetFlashMessage(c, utils.FlashError, "Invalid project ID")
-		c.Redirect(http.StatusFound, "/projects")
+	data := utils.GetTemplateData(c, isAuthenticated)
+	data.FormTitle = "Create Project"
+	data.ProjectStatuses = domain.ProjectStatuses
+	c.HTML(http.StatusOK, "form-template.html", data)
+}
+
+func (h *Handler) RenderUpdateProjectPage(c *gin.Context) {
+	userID, ok := sessionUserID(c, "Failed to load project")
+	if !ok {
 		return
 	}
-
-	project, err := h.ProjectUseCase.GetProjectByID(id)
-	if err != nil {
-		log.Printf("Project not found for ID %s: %v", idStr, err)
-		utils.SetFlashMessage(c, utils.FlashError, "Project not found")
-		c.Redirect(http.StatusFound, "/projects")
+	project, ok := h.loadAuthorizedProject(c, userID, application.ActionEdit)
+	if !ok {
 		return
 	}
 
-	// Ensure the authenticated user is the owner of the project
-	profile, err := h.UserUseCase.GetProfileByID(uuid.MustParse(userIDStr.(string)))
-	if err != nil || project.OwnerID != profile.ID {
-		utils.SetFlashMessage(c, utils.FlashError, "You don't have permission to delete this project")
-		c.Redirect(http.StatusFound, "/projects")
+	data := utils.GetTemplateData(c, true)
+	data.FormTitle = "Update Project"
+	data.Project = *project
+	data.ProjectStatuses = domain.ProjectStatuses
+	c.HTML(http.StatusOK, "form-template.html", data)
+}
+
+// RenderDeleteProjectPage renders the delete project page
+func (h *Handler) RenderDeleteProjectPage(c *gin.Context) {
+	userID, ok := sessionUserID(c, "Failed to load project")
+	if !ok {
+		return
+	}
+	project, ok := h.loadAuthorizedProject(c, userID, application.ActionDelete)
+	if !ok {
 		return
 	}
 
-	data := utils.GetTemplateData(c, isAuthenticated)
+	data := utils.GetTemplateData(c, true)
 	data.Object = project
+	data.TrashRetentionDays = trashRetentionDays
 	c.HTML(http.StatusOK, "delete.html", data)
 }
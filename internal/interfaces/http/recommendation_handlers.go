@@ -0,0 +1,81 @@
+package http
+
+import (
+	"log"
+	"net/http"
+
+	"devsearch-go/internal/application"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// GetSimilarProfiles handles fetching the developers most similar to a profile
+func (h *Handler) GetSimilarProfiles(c *gin.Context) {
+	profileID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid profile ID"})
+		return
+	}
+	viewer := viewerID(c)
+	if _, err := h.UserUseCase.GetVisibleProfile(profileID, viewer); err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "Profile not found"})
+		return
+	}
+
+	similar, err := h.RecommendationUseCase.GetSimilarProfiles(profileID, viewer, application.DefaultRecommendationLimit)
+	if err != nil {
+		log.Printf("Failed to load similar profiles of profile %s: %v", profileID.String(), err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to load similar developers"})
+		return
+	}
+
+	response := make([]gin.H, 0, len(similar))
+	for _, entry := range similar {
+		response = append(response, gin.H{
+			"profile_id":  entry.Neighbor.ID,
+			"name":        entry.Neighbor.Name,
+			"short_intro": entry.Neighbor.ShortIntro,
+			"url":         entry.Neighbor.URL(),
+			"score":       entry.Score,
+		})
+	}
+	c.JSON(http.StatusOK, response)
+}
+
+// GetRelatedProjects handles fetching the projects most similar to a project
+func (h *Handler) GetRelatedProjects(c *gin.Context) {
+	projectID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid project ID"})
+		return
+	}
+	project, err := h.ProjectUseCase.GetProjectByID(projectID)
+	if err != nil || !application.CanSeeProject(viewerPrincipal(c), project) {
+		c.JSON(http.StatusNotFound, gin.H{"error": "Project not found"})
+		return
+	}
+
+	related, err := h.RecommendationUseCase.GetRelatedProjects(projectID, application.DefaultRecommendationLimit)
+	if err != nil {
+		log.Printf("Failed to load related projects of project %s: %v", projectID.String(), err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to load related projects"})
+		return
+	}
+
+	response := make([]gin.H, 0, len(related))
+	for _, entry := range related {
+		tags := make([]string, 0, len(entry.Neighbor.Tags))
+		for _, tag := range entry.Neighbor.Tags {
+			tags = append(tags, tag.Name)
+		}
+		response = append(response, gin.H{
+			"project_id": entry.Neighbor.ID,
+			"title":      entry.Neighbor.Title,
+			"url":        entry.Neighbor.URL(),
+			"tags":       tags,
+			"score":      entry.Score,
+		})
+	}
+	c.JSON(http.StatusOK, response)
+}
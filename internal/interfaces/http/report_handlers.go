@@ -0,0 +1,97 @@
+package http
+
+import (
+	"errors"
+	"log"
+	"net/http"
+	"strconv"
+
+	"devsearch-go/internal/application"
+	"devsearch-go/internal/domain"
+	"devsearch-go/internal/infrastructure/utils"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// CreateReport handles reporting a project, profile, review or message to the moderators
+func (h *Handler) CreateReport(c *gin.Context) {
+	userID, ok := sessionUserID(c, "Failed to send report")
+	if !ok {
+		return
+	}
+	next := localRedirectTarget(c.PostForm("next"), "/")
+	targetID, err := uuid.Parse(c.PostForm("target_id"))
+	if err != nil {
+		utils.SetFlashMessage(c, utils.FlashError, "Invalid report")
+		c.Redirect(http.StatusFound, next)
+		return
+	}
+
+	kind := c.PostForm("target_kind")
+	_, err = h.ReportUseCase.ReportContent(userID, kind, targetID, c.PostForm("reason"), c.PostForm("details"))
+	switch {
+	case err == nil:
+		utils.SetFlashMessage(c, utils.FlashSuccess, "Thank you, your report was sent to the moderators")
+	case errors.Is(err, application.ErrInvalidReportTarget), errors.Is(err, application.ErrInvalidReportReason),
+		errors.Is(err, application.ErrReportTargetNotFound), errors.Is(err, application.ErrReportOwnContent):
+		utils.SetFlashMessage(c, utils.FlashError, err.Error())
+	case errors.Is(err, application.ErrAlreadyReported):
+		utils.SetFlashMessage(c, utils.FlashInfo, err.Error())
+	default:
+		log.Printf("Failed to report %s %s for user %s: %v", kind, targetID.String(), userID.String(), err)
+		utils.SetFlashMessage(c, utils.FlashError, "Failed to send report")
+	}
+	c.Redirect(http.StatusFound, next)
+}
+
+// RenderAdminReportsPage renders the moderation queue of the admin console
+func (h *Handler) RenderAdminReportsPage(c *gin.Context) {
+	userID, ok := sessionUserID(c, "Failed to load reports")
+	if !ok {
+		return
+	}
+	status := c.DefaultQuery("status", domain.ReportCaseOpen)
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+
+	reportCases, total, err := h.ReportUseCase.GetReportCases(userID, status, page, adminPageSize)
+	if h.adminAccessDenied(c, err, "Failed to load reports") {
+		return
+	}
+
+	data := h.adminTemplateData(c, "reports", "", total)
+	data.ReportCases = reportCases
+	data.ReportCaseStatuses = domain.ReportCaseStatuses
+	data.ReportStatus = status
+	c.HTML(http.StatusOK, "admin/admin.html", data)
+}
+
+// ResolveReportCase handles acting on or dismissing the reports about a piece of content
+func (h *Handler) ResolveReportCase(c *gin.Context) {
+	actorID, ok := sessionUserID(c, "Failed to resolve report")
+	if !ok {
+		return
+	}
+	caseID, ok := adminIDParam(c, "/admin/reports")
+	if !ok {
+		return
+	}
+
+	reportCase, err := h.ReportUseCase.ResolveReportCase(actorID, caseID, c.PostForm("resolution"), requestMeta(c))
+	switch {
+	case err == nil && reportCase.Status == domain.ReportCaseActioned && reportCase.TargetKind == domain.ReportTargetMessage:
+		// Messages are private to their recipient; acting on them means acting on the sender's account.
+		utils.SetFlashMessage(c, utils.FlashSuccess, "The report was marked as actioned")
+	case err == nil && reportCase.Status == domain.ReportCaseActioned:
+		utils.SetFlashMessage(c, utils.FlashSuccess, "The reported "+reportCase.TargetKind+" was hidden")
+	case err == nil:
+		utils.SetFlashMessage(c, utils.FlashSuccess, "The reports were dismissed")
+	case errors.Is(err, application.ErrInvalidResolution), errors.Is(err, application.ErrReportCaseNotFound),
+		errors.Is(err, application.ErrReportCaseResolved), errors.Is(err, application.ErrPermissionDenied):
+		utils.SetFlashMessage(c, utils.FlashError, err.Error())
+	default:
+		log.Printf("Failed to resolve report case %s by %s: %v", caseID.String(), actorID.String(), err)
+		utils.SetFlashMessage(c, utils.FlashError, "Failed to resolve report")
+	}
+	c.Redirect(http.StatusFound, localRedirectTarget(c.PostForm("next"), "/admin/reports"))
+}
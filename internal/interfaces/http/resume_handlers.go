@@ -0,0 +1,148 @@
+package http
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+
+	"devsearch-go/internal/application"
+	"devsearch-go/internal/infrastructure/utils"
+
+	"github.com/gin-contrib/sessions"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// maxResumeUploadSize limits the size of uploaded JSON Resume files.
+const maxResumeUploadSize = 1 << 20
+
+// GetJSONResume handles exporting a profile as a JSON Resume document
+func (h *Handler) GetJSONResume(c *gin.Context) {
+	idStr := c.Param("id")
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid profile ID"})
+		return
+	}
+
+	resume, err := h.ResumeUseCase.ExportJSONResume(id, viewerID(c), requestBaseURL(c))
+	if err != nil {
+		log.Printf("Failed to export JSON Resume for profile %s: %v", idStr, err)
+		c.JSON(http.StatusNotFound, gin.H{"error": "Profile not found"})
+		return
+	}
+
+	if c.Query("download") != "" {
+		c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=\"resume-%s.json\"", id.String()))
+	}
+	c.JSON(http.StatusOK, resume)
+}
+
+// GetResumePDF handles rendering a profile as a printable PDF resume
+func (h *Handler) GetResumePDF(c *gin.Context) {
+	idStr := c.Param("id")
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		utils.SetFlashMessage(c, utils.FlashError, "Invalid profile ID")
+		c.Redirect(http.StatusFound, "/profiles")
+		return
+	}
+
+	var buf bytes.Buffer
+	if err := h.ResumeUseCase.RenderPDF(&buf, id, viewerID(c), c.Query("layout"), requestBaseURL(c)); err != nil {
+		if errors.Is(err, application.ErrUnknownResumeLayout) {
+			utils.SetFlashMessage(c, utils.FlashError, "Unknown resume layout")
+			c.Redirect(http.StatusFound, fmt.Sprintf("/profile/%s", idStr))
+			return
+		}
+		if errors.Is(err, application.ErrProfileNotVisible) {
+			utils.SetFlashMessage(c, utils.FlashError, "Profile not found")
+			c.Redirect(http.StatusFound, "/profiles")
+			return
+		}
+		log.Printf("Failed to render resume for profile %s: %v", idStr, err)
+		utils.SetFlashMessage(c, utils.FlashError, "Failed to generate resume")
+		c.Redirect(http.StatusFound, "/profiles")
+		return
+	}
+
+	c.Header("Content-Disposition", fmt.Sprintf("inline; filename=\"resume-%s.pdf\"", id.String()))
+	c.Data(http.StatusOK, "application/pdf", buf.Bytes())
+}
+
+// RenderImportResumePage renders the JSON Resume upload page
+func (h *Handler) RenderImportResumePage(c *gin.Context) {
+	session := sessions.Default(c)
+	userIDStr := session.Get("userID")
+	isAuthenticated := userIDStr != nil
+
+	data := utils.GetTemplateData(c, isAuthenticated)
+	data.FormTitle = "Import JSON Resume"
+	c.HTML(http.StatusOK, "users/resume_import_form.html", data)
+}
+
+// ImportResume handles filling the authenticated user's profile from an uploaded JSON Resume file
+func (h *Handler) ImportResume(c *gin.Context) {
+	userIDStr := sessions.Default(c).Get("userID")
+	if userIDStr == nil {
+		utils.SetFlashMessage(c, utils.FlashError, "User not authenticated")
+		c.Redirect(http.StatusFound, "/login")
+		return
+	}
+	userID, err := uuid.Parse(userIDStr.(string))
+	if err != nil {
+		log.Printf("Invalid user ID in session: %v", err)
+		utils.SetFlashMessage(c, utils.FlashError, "Failed to import resume")
+		c.Redirect(http.StatusFound, "/login")
+		return
+	}
+
+	file, err := c.FormFile("resume_file")
+	if err != nil {
+		utils.SetFlashMessage(c, utils.FlashError, "Please choose a JSON Resume file")
+		c.Redirect(http.StatusFound, "/import-resume")
+		return
+	}
+	if file.Size > maxResumeUploadSize {
+		utils.SetFlashMessage(c, utils.FlashError, "Resume file is too large")
+		c.Redirect(http.StatusFound, "/import-resume")
+		return
+	}
+
+	src, err := file.Open()
+	if err != nil {
+		log.Printf("Failed to open resume file: %v", err)
+		utils.SetFlashMessage(c, utils.FlashError, "Failed to open resume file")
+		c.Redirect(http.StatusFound, "/import-resume")
+		return
+	}
+	defer src.Close()
+
+	resume, err := application.ParseJSONResume(src)
+	if err != nil {
+		utils.SetFlashMessage(c, utils.FlashError, err.Error())
+		c.Redirect(http.StatusFound, "/import-resume")
+		return
+	}
+
+	if _, err := h.ResumeUseCase.ImportJSONResume(userID, resume); err != nil {
+		log.Printf("Failed to import resume for user %s: %v", userID.String(), err)
+		utils.SetFlashMessage(c, utils.FlashError, "Failed to import resume")
+		c.Redirect(http.StatusFound, "/import-resume")
+		return
+	}
+
+	utils.SetFlashMessage(c, utils.FlashSuccess, "Resume was imported successfully!")
+	c.Redirect(http.StatusFound, "/account")
+}
+
+// requestBaseURL returns the scheme and host the request was made to.
+func requestBaseURL(c *gin.Context) string {
+	scheme := "http"
+	if c.Request.TLS != nil || c.GetHeader("X-Forwarded-Proto") == "https" {
+		scheme = "https"
+	}
+	return fmt.Sprintf("%s://%s", scheme, c.Request.Host)
+}
@@ -0,0 +1,172 @@
+package http
+
+import (
+	"errors"
+	"log"
+	"net/http"
+
+	"devsearch-go/internal/application"
+	"devsearch-go/internal/infrastructure/utils"
+
+	"github.com/gin-contrib/sessions"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// RenderSavedSearchesPage renders the user's saved searches with their new matches
+func (h *Handler) RenderSavedSearchesPage(c *gin.Context) {
+	userID, ok := sessionUserID(c, "Failed to load saved searches")
+	if !ok {
+		return
+	}
+
+	summaries, err := h.SavedSearchUseCase.GetSavedSearches(userID)
+	if err != nil {
+		log.Printf("Failed to load saved searches for user %s: %v", userID.String(), err)
+		utils.SetFlashMessage(c, utils.FlashError, "Failed to load saved searches")
+		c.Redirect(http.StatusFound, "/profiles")
+		return
+	}
+
+	data := utils.GetTemplateData(c, true)
+	data.SavedSearches = summaries
+	c.HTML(http.StatusOK, "users/saved_searches.html", data)
+}
+
+// SaveSearch handles saving the current developer search
+func (h *Handler) SaveSearch(c *gin.Context) {
+	userID, ok := sessionUserID(c, "Failed to save search")
+	if !ok {
+		return
+	}
+
+	filter := profileFilterFrom(c.PostForm)
+	if _, err := h.SavedSearchUseCase.SaveSearch(userID, c.PostForm("name"), filter, c.PostForm("alerts") == "on"); err != nil {
+		if errors.Is(err, application.ErrInvalidSavedSearchName) {
+			utils.SetFlashMessage(c, utils.FlashError, err.Error())
+		} else {
+			log.Printf("Failed to save search for user %s: %v", userID.String(), err)
+			utils.SetFlashMessage(c, utils.FlashError, "Failed to save search")
+		}
+		c.Redirect(http.StatusFound, "/profiles?"+profileFilterQuery(filter))
+		return
+	}
+
+	utils.SetFlashMessage(c, utils.FlashSuccess, "Search was saved!")
+	c.Redirect(http.StatusFound, "/saved-searches")
+}
+
+// OpenSavedSearch handles rerunning a saved search, marking its matches as seen
+func (h *Handler) OpenSavedSearch(c *gin.Context) {
+	userID, ok := sessionUserID(c, "Failed to open saved search")
+	if !ok {
+		return
+	}
+	searchID, ok := savedSearchIDParam(c)
+	if !ok {
+		return
+	}
+
+	filter, err := h.SavedSearchUseCase.OpenSavedSearch(userID, searchID)
+	if err != nil {
+		log.Printf("Failed to open saved search %s for user %s: %v", searchID.String(), userID.String(), err)
+		utils.SetFlashMessage(c, utils.FlashError, "Saved search not found")
+		c.Redirect(http.StatusFound, "/saved-searches")
+		return
+	}
+	c.Redirect(http.StatusFound, "/profiles?"+profileFilterQuery(filter))
+}
+
+// UpdateSavedSearchAlerts handles turning the new-match alerts of a saved search on or off
+func (h *Handler) UpdateSavedSearchAlerts(c *gin.Context) {
+	userID, ok := sessionUserID(c, "Failed to update alerts")
+	if !ok {
+		return
+	}
+	searchID, ok := savedSearchIDParam(c)
+	if !ok {
+		return
+	}
+
+	enabled := c.PostForm("alerts") == "on"
+	if err := h.SavedSearchUseCase.SetAlerts(userID, searchID, enabled); err != nil {
+		log.Printf("Failed to update alerts of saved search %s for user %s: %v", searchID.String(), userID.String(), err)
+		utils.SetFlashMessage(c, utils.FlashError, "Failed to update alerts")
+		c.Redirect(http.StatusFound, "/saved-searches")
+		return
+	}
+
+	if enabled {
+		utils.SetFlashMessage(c, utils.FlashSuccess, "You will be notified in your inbox about new matches")
+	} else {
+		utils.SetFlashMessage(c, utils.FlashSuccess, "Alerts were turned off")
+	}
+	c.Redirect(http.StatusFound, "/saved-searches")
+}
+
+// DeleteSavedSearch handles deleting a saved search
+func (h *Handler) DeleteSavedSearch(c *gin.Context) {
+	userID, ok := sessionUserID(c, "Failed to delete saved search")
+	if !ok {
+		return
+	}
+	searchID, ok := savedSearchIDParam(c)
+	if !ok {
+		return
+	}
+
+	if err := h.SavedSearchUseCase.DeleteSavedSearch(userID, searchID); err != nil {
+		log.Printf("Failed to delete saved search %s for user %s: %v", searchID.String(), userID.String(), err)
+		utils.SetFlashMessage(c, utils.FlashError, "Failed to delete saved search")
+		c.Redirect(http.StatusFound, "/saved-searches")
+		return
+	}
+
+	utils.SetFlashMessage(c, utils.FlashSuccess, "Saved search was deleted")
+	c.Redirect(http.StatusFound, "/saved-searches")
+}
+
+// GetSavedSearches handles fetching the user's saved searches with their new matches
+func (h *Handler) GetSavedSearches(c *gin.Context) {
+	userIDStr := sessions.Default(c).Get("userID")
+	if userIDStr == nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "User not authenticated"})
+		return
+	}
+	userID, err := uuid.Parse(userIDStr.(string))
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Invalid user ID in session"})
+		return
+	}
+
+	summaries, err := h.SavedSearchUseCase.GetSavedSearches(userID)
+	if err != nil {
+		log.Printf("Failed to load saved searches for user %s: %v", userID.String(), err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to load saved searches"})
+		return
+	}
+
+	response := make([]gin.H, 0, len(summaries))
+	for _, summary := range summaries {
+		response = append(response, gin.H{
+			"id":             summary.Search.ID,
+			"name":           summary.Search.Name,
+			"query":          profileFilterQuery(application.SavedSearchFilter(summary.Search)),
+			"alerts_enabled": summary.Search.AlertsEnabled,
+			"new_matches":    summary.NewMatches,
+			"last_viewed_at": summary.Search.LastViewedAt,
+		})
+	}
+	c.JSON(http.StatusOK, response)
+}
+
+// savedSearchIDParam parses the saved search ID route parameter, redirecting to the saved searches page when it is invalid.
+func savedSearchIDParam(c *gin.Context) (uuid.UUID, bool) {
+	searchID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.SetFlashMessage(c, utils.FlashError, "Invalid saved search ID")
+		c.Redirect(http.StatusFound, "/saved-searches")
+		return uuid.Nil, false
+	}
+	return searchID, true
+}
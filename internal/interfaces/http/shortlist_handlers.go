@@ -0,0 +1,313 @@
+package http
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+
+	"devsearch-go/internal/application"
+	"devsearch-go/internal/domain"
+	"devsearch-go/internal/infrastructure/utils"
+
+	"github.com/gin-contrib/sessions"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// RenderShortlistsPage renders the user's shortlists
+func (h *Handler) RenderShortlistsPage(c *gin.Context) {
+	userID, ok := sessionUserID(c, "Failed to load shortlists")
+	if !ok {
+		return
+	}
+
+	shortlists, err := h.ShortlistUseCase.GetShortlists(userID)
+	if err != nil {
+		log.Printf("Failed to load shortlists for user %s: %v", userID.String(), err)
+		utils.SetFlashMessage(c, utils.FlashError, "Failed to load shortlists")
+		c.Redirect(http.StatusFound, "/account")
+		return
+	}
+
+	data := utils.GetTemplateData(c, true)
+	data.Shortlists = shortlists
+	c.HTML(http.StatusOK, "users/shortlists.html", data)
+}
+
+// RenderShortlistPage renders a shortlist with its candidate pipeline
+func (h *Handler) RenderShortlistPage(c *gin.Context) {
+	userID, ok := sessionUserID(c, "Failed to load shortlist")
+	if !ok {
+		return
+	}
+	shortlistID, ok := shortlistIDParam(c)
+	if !ok {
+		return
+	}
+
+	shortlist, err := h.ShortlistUseCase.GetShortlist(userID, shortlistID)
+	if err != nil {
+		log.Printf("Failed to load shortlist %s for user %s: %v", shortlistID.String(), userID.String(), err)
+		utils.SetFlashMessage(c, utils.FlashError, "Shortlist not found")
+		c.Redirect(http.StatusFound, "/shortlists")
+		return
+	}
+
+	data := utils.GetTemplateData(c, true)
+	data.Shortlist = *shortlist
+	data.PipelineStages = domain.PipelineStages
+	c.HTML(http.StatusOK, "users/shortlist.html", data)
+}
+
+// CreateShortlist handles creating a new shortlist
+func (h *Handler) CreateShortlist(c *gin.Context) {
+	userID, ok := sessionUserID(c, "Failed to create shortlist")
+	if !ok {
+		return
+	}
+
+	shortlist, err := h.ShortlistUseCase.CreateShortlist(userID, c.PostForm("name"))
+	if err != nil {
+		if errors.Is(err, application.ErrInvalidShortlistName) {
+			utils.SetFlashMessage(c, utils.FlashError, err.Error())
+		} else {
+			log.Printf("Failed to create shortlist for user %s: %v", userID.String(), err)
+			utils.SetFlashMessage(c, utils.FlashError, "Failed to create shortlist")
+		}
+		c.Redirect(http.StatusFound, "/shortlists")
+		return
+	}
+
+	utils.SetFlashMessage(c, utils.FlashSuccess, "Shortlist was created successfully!")
+	c.Redirect(http.StatusFound, fmt.Sprintf("/shortlist/%s", shortlist.ID))
+}
+
+// RenameShortlist handles renaming a shortlist
+func (h *Handler) RenameShortlist(c *gin.Context) {
+	userID, ok := sessionUserID(c, "Failed to rename shortlist")
+	if !ok {
+		return
+	}
+	shortlistID, ok := shortlistIDParam(c)
+	if !ok {
+		return
+	}
+
+	shortlistURL := fmt.Sprintf("/shortlist/%s", shortlistID)
+	if err := h.ShortlistUseCase.RenameShortlist(userID, shortlistID, c.PostForm("name")); err != nil {
+		if errors.Is(err, application.ErrInvalidShortlistName) || errors.Is(err, application.ErrShortlistNotFound) {
+			utils.SetFlashMessage(c, utils.FlashError, err.Error())
+		} else {
+			log.Printf("Failed to rename shortlist %s for user %s: %v", shortlistID.String(), userID.String(), err)
+			utils.SetFlashMessage(c, utils.FlashError, "Failed to rename shortlist")
+		}
+		c.Redirect(http.StatusFound, shortlistURL)
+		return
+	}
+
+	utils.SetFlashMessage(c, utils.FlashSuccess, "Shortlist was renamed successfully!")
+	c.Redirect(http.StatusFound, shortlistURL)
+}
+
+// DeleteShortlist handles deleting a shortlist with its candidates and notes
+func (h *Handler) DeleteShortlist(c *gin.Context) {
+	userID, ok := sessionUserID(c, "Failed to delete shortlist")
+	if !ok {
+		return
+	}
+	shortlistID, ok := shortlistIDParam(c)
+	if !ok {
+		return
+	}
+
+	if err := h.ShortlistUseCase.DeleteShortlist(userID, shortlistID); err != nil {
+		log.Printf("Failed to delete shortlist %s for user %s: %v", shortlistID.String(), userID.String(), err)
+		utils.SetFlashMessage(c, utils.FlashError, "Failed to delete shortlist")
+		c.Redirect(http.StatusFound, "/shortlists")
+		return
+	}
+
+	utils.SetFlashMessage(c, utils.FlashSuccess, "Shortlist was deleted successfully!")
+	c.Redirect(http.StatusFound, "/shortlists")
+}
+
+// AddToShortlist handles adding a profile to one of the user's shortlists
+func (h *Handler) AddToShortlist(c *gin.Context) {
+	userID, ok := sessionUserID(c, "Failed to add to shortlist")
+	if !ok {
+		return
+	}
+
+	next := localRedirectTarget(c.PostForm("next"), "/profiles")
+	profileID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.SetFlashMessage(c, utils.FlashError, "Invalid profile ID")
+		c.Redirect(http.StatusFound, next)
+		return
+	}
+	shortlistID, err := uuid.Parse(c.PostForm("shortlist_id"))
+	if err != nil {
+		utils.SetFlashMessage(c, utils.FlashError, "Please choose a shortlist")
+		c.Redirect(http.StatusFound, next)
+		return
+	}
+
+	_, err = h.ShortlistUseCase.AddCandidate(userID, shortlistID, profileID)
+	switch {
+	case err == nil:
+		utils.SetFlashMessage(c, utils.FlashSuccess, "Developer was added to the shortlist")
+	case errors.Is(err, application.ErrAlreadyShortlisted):
+		utils.SetFlashMessage(c, utils.FlashInfo, err.Error())
+	case errors.Is(err, application.ErrShortlistNotFound), errors.Is(err, application.ErrProfileNotVisible):
+		utils.SetFlashMessage(c, utils.FlashError, "Shortlist or profile not found")
+	default:
+		log.Printf("Failed to add profile %s to shortlist %s: %v", profileID.String(), shortlistID.String(), err)
+		utils.SetFlashMessage(c, utils.FlashError, "Failed to add to shortlist")
+	}
+	c.Redirect(http.StatusFound, next)
+}
+
+// UpdateCandidate handles moving a candidate through the pipeline and editing its note
+func (h *Handler) UpdateCandidate(c *gin.Context) {
+	h.changeCandidate(c, func(userID, candidateID uuid.UUID) (*domain.ShortlistCandidate, error) {
+		return h.ShortlistUseCase.UpdateCandidate(userID, candidateID, c.PostForm("stage"), c.PostForm("note"))
+	}, "Candidate was updated")
+}
+
+// RemoveCandidate handles taking a candidate off a shortlist
+func (h *Handler) RemoveCandidate(c *gin.Context) {
+	h.changeCandidate(c, h.ShortlistUseCase.RemoveCandidate, "Candidate was removed from the shortlist")
+}
+
+// changeCandidate runs a candidate action for the authenticated user and redirects back to its shortlist.
+func (h *Handler) changeCandidate(c *gin.Context, action func(userID, candidateID uuid.UUID) (*domain.ShortlistCandidate, error), successMessage string) {
+	userID, ok := sessionUserID(c, "Failed to update candidate")
+	if !ok {
+		return
+	}
+	idStr := c.Param("id")
+	candidateID, err := uuid.Parse(idStr)
+	if err != nil {
+		utils.SetFlashMessage(c, utils.FlashError, "Invalid candidate ID")
+		c.Redirect(http.StatusFound, "/shortlists")
+		return
+	}
+
+	candidate, err := action(userID, candidateID)
+	if err != nil {
+		if errors.Is(err, application.ErrInvalidPipelineStage) || errors.Is(err, application.ErrCandidateNotFound) {
+			utils.SetFlashMessage(c, utils.FlashError, err.Error())
+		} else {
+			log.Printf("Failed to update candidate %s for user %s: %v", idStr, userID.String(), err)
+			utils.SetFlashMessage(c, utils.FlashError, "Failed to update candidate")
+		}
+		c.Redirect(http.StatusFound, localRedirectTarget(c.PostForm("next"), "/shortlists"))
+		return
+	}
+
+	utils.SetFlashMessage(c, utils.FlashSuccess, successMessage)
+	c.Redirect(http.StatusFound, fmt.Sprintf("/shortlist/%s", candidate.ShortlistID))
+}
+
+// ExportShortlistCSV handles downloading a shortlist as a CSV file
+func (h *Handler) ExportShortlistCSV(c *gin.Context) {
+	userID, ok := sessionUserID(c, "Failed to export shortlist")
+	if !ok {
+		return
+	}
+	shortlistID, ok := shortlistIDParam(c)
+	if !ok {
+		return
+	}
+
+	var buf bytes.Buffer
+	if err := h.ShortlistUseCase.ExportCSV(&buf, userID, shortlistID, requestBaseURL(c)); err != nil {
+		log.Printf("Failed to export shortlist %s for user %s: %v", shortlistID.String(), userID.String(), err)
+		utils.SetFlashMessage(c, utils.FlashError, "Failed to export shortlist")
+		c.Redirect(http.StatusFound, "/shortlists")
+		return
+	}
+
+	c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=\"shortlist-%s.csv\"", shortlistID.String()))
+	c.Data(http.StatusOK, "text/csv; charset=utf-8", buf.Bytes())
+}
+
+// GetShortlists handles fetching the user's shortlists with their candidates' stages
+func (h *Handler) GetShortlists(c *gin.Context) {
+	userIDStr := sessions.Default(c).Get("userID")
+	if userIDStr == nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "User not authenticated"})
+		return
+	}
+	userID, err := uuid.Parse(userIDStr.(string))
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Invalid user ID in session"})
+		return
+	}
+
+	shortlists, err := h.ShortlistUseCase.GetShortlists(userID)
+	if err != nil {
+		log.Printf("Failed to load shortlists for user %s: %v", userID.String(), err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to load shortlists"})
+		return
+	}
+
+	response := make([]gin.H, 0, len(shortlists))
+	for _, shortlist := range shortlists {
+		candidates := make([]gin.H, 0, len(shortlist.Candidates))
+		for _, candidate := range shortlist.Candidates {
+			candidates = append(candidates, gin.H{
+				"id":         candidate.ID,
+				"profile_id": candidate.ProfileID,
+				"stage":      candidate.Stage,
+				"note":       candidate.Note,
+			})
+		}
+		response = append(response, gin.H{
+			"id":         shortlist.ID,
+			"name":       shortlist.Name,
+			"candidates": candidates,
+		})
+	}
+	c.JSON(http.StatusOK, response)
+}
+
+// sessionUserID reads the authenticated user's ID, redirecting to the login page when there is none.
+func sessionUserID(c *gin.Context, failureMessage string) (uuid.UUID, bool) {
+	userIDStr := sessions.Default(c).Get("userID")
+	if userIDStr == nil {
+		utils.SetFlashMessage(c, utils.FlashError, "User not authenticated")
+		c.Redirect(http.StatusFound, "/login")
+		return uuid.Nil, false
+	}
+	userID, err := uuid.Parse(userIDStr.(string))
+	if err != nil {
+		log.Printf("Invalid user ID in session: %v", err)
+		utils.SetFlashMessage(c, utils.FlashError, failureMessage)
+		c.Redirect(http.StatusFound, "/login")
+		return uuid.Nil, false
+	}
+	return userID, true
+}
+
+// shortlistIDParam parses the shortlist ID route parameter, redirecting to the shortlists page when it is invalid.
+func shortlistIDParam(c *gin.Context) (uuid.UUID, bool) {
+	shortlistID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.SetFlashMessage(c, utils.FlashError, "Invalid shortlist ID")
+		c.Redirect(http.StatusFound, "/shortlists")
+		return uuid.Nil, false
+	}
+	return shortlistID, true
+}
+
+// localRedirectTarget returns next when it is a path on this site, and fallback otherwise.
+func localRedirectTarget(next, fallback string) string {
+	if !strings.HasPrefix(next, "/") || strings.HasPrefix(next, "//") || strings.HasPrefix(next, "/\\") {
+		return fallback
+	}
+	return next
+}
@@ -0,0 +1,35 @@
+package http
+
+import (
+	"log"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// skillCatalogSuggestions is the number of catalog skills returned for autocomplete.
+const skillCatalogSuggestions = 10
+
+// SearchSkillCatalog handles skill name autocomplete against the skill catalog
+func (h *Handler) SearchSkillCatalog(c *gin.Context) {
+	catalogSkills, err := h.SkillCatalogUseCase.SearchCatalog(c.Query("q"), skillCatalogSuggestions)
+	if err != nil {
+		log.Printf("Failed to search skill catalog: %v", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to search skill catalog"})
+		return
+	}
+
+	suggestions := make([]gin.H, 0, len(catalogSkills))
+	for _, catalogSkill := range catalogSkills {
+		aliases := make([]string, 0, len(catalogSkill.Aliases))
+		for _, alias := range catalogSkill.Aliases {
+			aliases = append(aliases, alias.Alias)
+		}
+		suggestions = append(suggestions, gin.H{
+			"id":      catalogSkill.ID,
+			"name":    catalogSkill.Name,
+			"aliases": aliases,
+		})
+	}
+	c.JSON(http.StatusOK, suggestions)
+}
@@ -0,0 +1,124 @@
+package http
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"time"
+
+	"devsearch-go/internal/application"
+	"devsearch-go/internal/infrastructure/utils"
+
+	"github.com/gin-contrib/sessions"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// trashRetentionDays is TrashRetention in days, as shown on the account and delete pages.
+const trashRetentionDays = int(application.TrashRetention / (24 * time.Hour))
+
+// RestoreProject handles taking a deleted project out of the trash
+func (h *Handler) RestoreProject(c *gin.Context) {
+	userID, ok := sessionUserID(c, "Failed to restore project")
+	if !ok {
+		return
+	}
+	projectID, ok := trashIDParam(c)
+	if !ok {
+		return
+	}
+
+	project, err := h.TrashUseCase.RestoreProject(userID, projectID, requestMeta(c))
+	switch {
+	case err == nil:
+		utils.SetFlashMessage(c, utils.FlashSuccess, "Project "+project.Title+" was restored")
+	case errors.Is(err, application.ErrTrashItemNotFound), errors.Is(err, application.ErrTrashItemExpired):
+		utils.SetFlashMessage(c, utils.FlashError, err.Error())
+	default:
+		log.Printf("Failed to restore project %s for user %s: %v", projectID.String(), userID.String(), err)
+		utils.SetFlashMessage(c, utils.FlashError, "Failed to restore project")
+	}
+	c.Redirect(http.StatusFound, "/account")
+}
+
+// RestoreSkill handles taking a deleted skill out of the trash
+func (h *Handler) RestoreSkill(c *gin.Context) {
+	userID, ok := sessionUserID(c, "Failed to restore skill")
+	if !ok {
+		return
+	}
+	skillID, ok := trashIDParam(c)
+	if !ok {
+		return
+	}
+
+	skill, err := h.TrashUseCase.RestoreSkill(userID, skillID)
+	switch {
+	case err == nil:
+		utils.SetFlashMessage(c, utils.FlashSuccess, "Skill "+skill.Name+" was restored")
+	case errors.Is(err, application.ErrTrashItemNotFound), errors.Is(err, application.ErrTrashItemExpired):
+		utils.SetFlashMessage(c, utils.FlashError, err.Error())
+	default:
+		log.Printf("Failed to restore skill %s for user %s: %v", skillID.String(), userID.String(), err)
+		utils.SetFlashMessage(c, utils.FlashError, "Failed to restore skill")
+	}
+	c.Redirect(http.StatusFound, "/account")
+}
+
+// RenderDeleteAccountPage renders the delete account page
+func (h *Handler) RenderDeleteAccountPage(c *gin.Context) {
+	userID, ok := sessionUserID(c, "Failed to get user account")
+	if !ok {
+		return
+	}
+	profile, err := h.UserUseCase.GetProfileByUserID(userID)
+	if err != nil {
+		log.Printf("Profile not found for user %s: %v", userID.String(), err)
+		utils.SetFlashMessage(c, utils.FlashError, "User not found")
+		c.Redirect(http.StatusFound, "/login")
+		return
+	}
+
+	data := utils.GetTemplateData(c, true)
+	data.Profile = *profile
+	data.TrashRetentionDays = trashRetentionDays
+	c.HTML(http.StatusOK, "users/delete_account.html", data)
+}
+
+// DeleteAccount handles moving the user's account to the trash and logging them out
+func (h *Handler) DeleteAccount(c *gin.Context) {
+	userID, ok := sessionUserID(c, "Failed to delete account")
+	if !ok {
+		return
+	}
+
+	if err := h.UserUseCase.DeleteAccount(userID, requestMeta(c)); err != nil {
+		log.Printf("Failed to delete account of user %s: %v", userID.String(), err)
+		utils.SetFlashMessage(c, utils.FlashError, "Failed to delete account")
+		c.Redirect(http.StatusFound, "/account")
+		return
+	}
+
+	session := sessions.Default(c)
+	session.Clear()
+	session.Options(sessions.Options{MaxAge: -1}) // Expire the cookie
+	if err := session.Save(); err != nil {
+		log.Printf("Failed to save session after deleting account of user %s: %v", userID.String(), err)
+	}
+
+	utils.SetFlashMessage(c, utils.FlashInfo, fmt.Sprintf("Your account was deleted. Log in again within %d days to restore it.", trashRetentionDays))
+	c.Redirect(http.StatusFound, "/login")
+}
+
+// trashIDParam parses the ID route parameter of a trash item, redirecting to the account
+// page when it is malformed.
+func trashIDParam(c *gin.Context) (uuid.UUID, bool) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.SetFlashMessage(c, utils.FlashError, "Invalid item ID")
+		c.Redirect(http.StatusFound, "/account")
+		return uuid.Nil, false
+	}
+	return id, true
+}
@@ -1,14 +1,18 @@
 package http
 
 import (
+	"errors"
 	"fmt"
 	"io"
 	"log"
 	"net/http"
+	"net/url"
 	"os"
 	"path/filepath"
 	"strconv"
+	"strings"
 
+	"devsearch-go/internal/application"
 	"devsearch-go/internal/domain"
 	"devsearch-go/internal/infrastructure/utils"
 
@@ -21,11 +25,11 @@ import (
 func (h *Handler) GetProfiles(c *gin.Context) {
 	var profiles []domain.Profile
 
-	searchQuery := c.Query("search_query")
+	filter := profileFilterFromQuery(c)
 	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
 	limit := 3
 
-	profiles, _, err := h.UserUseCase.GetAllProfiles(searchQuery, page, limit)
+	profiles, _, err := h.UserUseCase.GetAllProfiles(filter, viewerID(c), page, limit)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to fetch profiles"})
 		return
@@ -43,7 +47,28 @@ func (h *Handler) GetUserProfile(c *gin.Context) {
 		return
 	}
 
-	profile, err := h.UserUseCase.GetProfileByID(id)
+	profile, err := h.UserUseCase.GetVisibleProfile(id, viewerID(c))
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "Profile not found"})
+		return
+	}
+
+	c.JSON(http.StatusOK, profile)
+}
+
+// GetUserProfileByUsername handles fetching a single user profile by username
+func (h *Handler) GetUserProfileByUsername(c *gin.Context) {
+	profile, moved, err := h.UserUseCase.GetProfileByUsername(c.Param("username"))
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "Profile not found"})
+		return
+	}
+	if moved {
+		c.Redirect(http.StatusMovedPermanently, "/api"+profile.URL())
+		return
+	}
+
+	profile, err = h.UserUseCase.GetVisibleProfile(profile.ID, viewerID(c))
 	if err != nil {
 		c.JSON(http.StatusNotFound, gin.H{"error": "Profile not found"})
 		return
@@ -65,7 +90,7 @@ func (h *Handler) RegisterUser(c *gin.Context) {
 		return
 	}
 
-	_, _, err := h.UserUseCase.RegisterUser(username, email, password)
+	_, _, err := h.UserUseCase.RegisterUser(username, email, password, requestMeta(c))
 	if err != nil {
 		log.Printf("Failed to register user: %v", err)
 		utils.SetFlashMessage(c, utils.FlashError, err.Error())
@@ -74,7 +99,7 @@ func (h *Handler) RegisterUser(c *gin.Context) {
 	}
 
 	// Set user ID in session upon successful registration (requires finding the user again)
-	user, err := h.UserUseCase.LoginUser(username, password)
+	user, err := h.UserUseCase.LoginUser(username, password, requestMeta(c))
 	if err != nil {
 		log.Printf("Failed to log in user automatically after registration: %v", err)
 		utils.SetFlashMessage(c, utils.FlashError, "Failed to log in user automatically")
@@ -100,7 +125,7 @@ func (h *Handler) LoginUser(c *gin.Context) {
 	username := c.PostForm("username")
 	password := c.PostForm("password")
 
-	user, err := h.UserUseCase.LoginUser(username, password)
+	user, err := h.UserUseCase.LoginUser(username, password, requestMeta(c))
 	if err != nil {
 		utils.SetFlashMessage(c, utils.FlashError, err.Error())
 		c.Redirect(http.StatusFound, "/login")
@@ -122,6 +147,9 @@ func (h *Handler) LoginUser(c *gin.Context) {
 
 // LogoutUser handles user logout
 func (h *Handler) LogoutUser(c *gin.Context) {
+	if userID := viewerID(c); userID != uuid.Nil {
+		h.UserUseCase.LogoutUser(userID, requestMeta(c))
+	}
 	session := sessions.Default(c)
 	session.Clear()
 	session.Options(sessions.Options{MaxAge: -1}) // Expire the cookie
@@ -182,14 +210,14 @@ func (h *Handler) UpdateUserAccount(c *gin.Context) {
 	}
 
 	profileData := map[string]string{
-		"name":           c.PostForm("name"),
-		"email":          c.PostForm("email"),
-		"username":       c.PostForm("username"),
-		"short_intro":    c.PostForm("short_intro"),
-		"bio":            c.PostForm("bio"),
-		"social_github":  c.PostForm("social_github"),
-		"social_youtube": c.PostForm("social_youtube"),
-		"social_website": c.PostForm("social_website"),
+		"name":            c.PostForm("name"),
+		"email":           c.PostForm("email"),
+		"username":        c.PostForm("username"),
+		"short_intro":     c.PostForm("short_intro"),
+		"bio":             c.PostForm("bio"),
+		"social_github":   c.PostForm("social_github"),
+		"social_linkedin": c.PostForm("social_linkedin"),
+		"social_website":  c.PostForm("social_website"),
 	}
 
 	var profileImage string
@@ -232,7 +260,12 @@ func (h *Handler) UpdateUserAccount(c *gin.Context) {
 		return
 	}
 
-	_, err = h.UserUseCase.UpdateUserAccount(userID, profileData, profileImage)
+	_, err = h.UserUseCase.UpdateUserAccount(userID, profileData, profileImage, requestMeta(c))
+	if errors.Is(err, application.ErrInvalidUsername) || errors.Is(err, application.ErrUsernameTaken) || errors.Is(err, application.ErrInvalidLink) {
+		utils.SetFlashMessage(c, utils.FlashError, err.Error())
+		c.Redirect(http.StatusFound, "/edit-account")
+		return
+	}
 	if err != nil {
 		log.Printf("Failed to update profile for user %s: %v", userID.String(), err)
 		utils.SetFlashMessage(c, utils.FlashError, "Failed to update profile")
@@ -262,8 +295,14 @@ func (h *Handler) CreateSkill(c *gin.Context) {
 
 	name := c.PostForm("name")
 	description := c.PostForm("description")
+	level, years, err := skillProficiencyFromForm(c)
+	if err != nil {
+		utils.SetFlashMessage(c, utils.FlashError, err.Error())
+		c.Redirect(http.StatusFound, "/create-skill")
+		return
+	}
 
-	_, err = h.UserUseCase.CreateSkill(userID, name, description)
+	_, err = h.UserUseCase.CreateSkill(userID, name, description, level, years)
 	if err != nil {
 		log.Printf("Failed to create skill for user %s: %v", userID.String(), err)
 		utils.SetFlashMessage(c, utils.FlashError, "Failed to create skill")
@@ -302,8 +341,14 @@ func (h *Handler) UpdateSkill(c *gin.Context) {
 
 	name := c.PostForm("name")
 	description := c.PostForm("description")
+	level, years, err := skillProficiencyFromForm(c)
+	if err != nil {
+		utils.SetFlashMessage(c, utils.FlashError, err.Error())
+		c.Redirect(http.StatusFound, fmt.Sprintf("/update-skill/%s", idStr))
+		return
+	}
 
-	_, err = h.UserUseCase.UpdateSkill(id, userID, name, description)
+	_, err = h.UserUseCase.UpdateSkill(id, userID, name, description, level, years)
 	if err != nil {
 		log.Printf("Failed to update skill %s for user %s: %v", idStr, userID.String(), err)
 		utils.SetFlashMessage(c, utils.FlashError, err.Error())
@@ -347,7 +392,7 @@ func (h *Handler) DeleteSkill(c *gin.Context) {
 		return
 	}
 
-	utils.SetFlashMessage(c, utils.FlashSuccess, "Skill was deleted successfully!")
+	utils.SetFlashMessage(c, utils.FlashSuccess, "Skill was moved to the trash. You can restore it from your account page.")
 	c.Redirect(http.StatusFound, "/account")
 }
 
@@ -461,6 +506,12 @@ func (h *Handler) RenderAccountPage(c *gin.Context) {
 	var profile domain.Profile
 	var skills []domain.Skill
 	var projects []domain.Project
+	var analytics *application.AnalyticsReport
+	var jobPostings []domain.JobPosting
+	var jobMatches []application.JobPostingMatch
+	var invitations []domain.ProjectCollaborator
+	var trash []application.TrashEntry
+	var brokenLinks int
 
 	if isAuthenticated {
 		userID, err := uuid.Parse(userIDStr.(string))
@@ -471,23 +522,48 @@ func (h *Handler) RenderAccountPage(c *gin.Context) {
 			return
 		}
 
-		userAccount, err := h.UserUseCase.GetUserAccount(userID)
+		userProfile, err := h.UserUseCase.GetProfileByUserID(userID)
 		if err != nil {
-			log.Printf("User not found for ID %s: %v", userID.String(), err)
+			log.Printf("Profile not found for user %s: %v", userID.String(), err)
 			utils.SetFlashMessage(c, utils.FlashError, "User not found")
 			c.Redirect(http.StatusFound, "/login")
 			return
 		}
-		profile = userAccount.Profile
-		skills = userAccount.Profile.Skills
-		// userAccount.Profile.Projects undefined - Projects is on User, not Profile
-		projects = userAccount.Projects // Corrected access
+		profile = *userProfile
+		skills = userProfile.Skills
+		projects = userProfile.Projects
+
+		if analytics, err = h.AnalyticsUseCase.GetOwnerReport(userProfile); err != nil {
+			log.Printf("Failed to load analytics for user %s: %v", userID.String(), err)
+		}
+		if jobPostings, err = h.JobPostingUseCase.GetUserJobPostings(userID); err != nil {
+			log.Printf("Failed to load job postings for user %s: %v", userID.String(), err)
+		}
+		if jobMatches, err = h.JobPostingUseCase.RankPostingsForProfile(userID, application.DefaultJobMatchLimit); err != nil {
+			log.Printf("Failed to load job matches for user %s: %v", userID.String(), err)
+		}
+		if invitations, err = h.CollaboratorUseCase.GetPendingInvitations(userID); err != nil {
+			log.Printf("Failed to load project invitations for user %s: %v", userID.String(), err)
+		}
+		if trash, err = h.TrashUseCase.GetTrash(userID); err != nil {
+			log.Printf("Failed to load trash for user %s: %v", userID.String(), err)
+		}
+		if brokenLinks, err = h.LinkHealthUseCase.CountBrokenLinks(userID); err != nil {
+			log.Printf("Failed to count broken links for user %s: %v", userID.String(), err)
+		}
 	}
 
 	data := utils.GetTemplateData(c, isAuthenticated)
 	data.Profile = profile
 	data.Skills = skills
 	data.Projects = projects
+	data.Analytics = analytics
+	data.JobPostings = jobPostings
+	data.JobPostingMatches = jobMatches
+	data.Invitations = invitations
+	data.Trash = trash
+	data.TrashRetentionDays = trashRetentionDays
+	data.BrokenLinkCount = brokenLinks
 	c.HTML(http.StatusOK, "users/account.html", data)
 }
 
@@ -529,82 +605,61 @@ func (h *Handler) RenderCreateSkillPage(c *gin.Context) {
 
 	data := utils.GetTemplateData(c, isAuthenticated)
 	data.FormTitle = "Create Skill"
+	data.SkillLevels = domain.SkillLevels
 	c.HTML(http.StatusOK, "users/skill_form.html", data)
 }
 
 // RenderUpdateSkillPage renders the update skill page
 func (h *Handler) RenderUpdateSkillPage(c *gin.Context) {
-	session := sessions.Default(c)
-	userIDStr := session.Get("userID")
-	isAuthenticated := userIDStr != nil
-
-	var skill domain.Skill
-	if isAuthenticated {
-		_, err := uuid.Parse(userIDStr.(string)) // Removed userID assignment
-		if err != nil {
-			log.Printf("Invalid user ID in session: %v", err)
-			utils.SetFlashMessage(c, utils.FlashError, "Failed to get skill")
-			c.Redirect(http.StatusFound, "/login")
-			return
-		}
-		idStr := c.Param("id")
-		id, err := uuid.Parse(idStr)
-		if err != nil {
-			utils.SetFlashMessage(c, utils.FlashError, "Invalid skill ID")
-			c.Redirect(http.StatusFound, "/account")
-			return
-		}
-		retrievedSkill, err := h.UserUseCase.GetSkillByID(id)
-		if err != nil {
-			log.Printf("Skill not found for ID %s: %v", idStr, err)
-			utils.SetFlashMessage(c, utils.FlashError, "Skill not found")
-			c.Redirect(http.StatusFound, "/account")
-			return
-		}
-		skill = *retrievedSkill
+	skill, ok := h.loadUserSkill(c)
+	if !ok {
+		return
 	}
-	data := utils.GetTemplateData(c, isAuthenticated)
+
+	data := utils.GetTemplateData(c, true)
 	data.FormTitle = "Update Skill"
-	data.Skill = skill
+	data.Skill = *skill
+	data.SkillLevels = domain.SkillLevels
 	c.HTML(http.StatusOK, "users/skill_form.html", data)
 }
 
 // RenderDeleteSkillPage renders the delete skill page
 func (h *Handler) RenderDeleteSkillPage(c *gin.Context) {
-	session := sessions.Default(c)
-	userIDStr := session.Get("userID")
-	isAuthenticated := userIDStr != nil
-
-	var skill domain.Skill
-	if isAuthenticated {
-		_, err := uuid.Parse(userIDStr.(string)) // Removed userID assignment
-		if err != nil {
-			log.Printf("Invalid user ID in session: %v", err)
-			utils.SetFlashMessage(c, utils.FlashError, "Failed to get skill")
-			c.Redirect(http.StatusFound, "/login")
-			return
-		}
-		idStr := c.Param("id")
-		id, err := uuid.Parse(idStr)
-		if err != nil {
-			utils.SetFlashMessage(c, utils.FlashError, "Invalid skill ID")
-			c.Redirect(http.StatusFound, "/account")
-			return
-		}
-		retrievedSkill, err := h.UserUseCase.GetSkillByID(id)
-		if err != nil {
-			log.Printf("Skill not found for ID %s: %v", idStr, err)
-			utils.SetFlashMessage(c, utils.FlashError, "Skill not found")
-			c.Redirect(http.StatusFound, "/account")
-			return
-		}
-		skill = *retrievedSkill
+	skill, ok := h.loadUserSkill(c)
+	if !ok {
+		return
 	}
-	data := utils.GetTemplateData(c, isAuthenticated)
-	data.Object = skill
+
+	data := utils.GetTemplateData(c, true)
+	data.Object = *skill
+	data.TrashRetentionDays = trashRetentionDays
 	c.HTML(http.StatusOK, "delete.html", data)
 }
 
+// loadUserSkill loads the signed-in user's skill named by the ID route parameter, redirecting
+// to the account page when it is missing or belongs to someone else.
+func (h *Handler) loadUserSkill(c *gin.Context) (*domain.Skill, bool) {
+	userID, ok := sessionUserID(c, "Failed to get skill")
+	if !ok {
+		return nil, false
+	}
+	skillID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.SetFlashMessage(c, utils.FlashError, "Invalid skill ID")
+		c.Redirect(http.StatusFound, "/account")
+		return nil, false
+	}
+
+	skill, err := h.UserUseCase.GetUserSkill(skillID, userID)
+	if err != nil {
+		log.Printf("Skill %s not found for user %s: %v", skillID.String(), userID.String(), err)
+		utils.SetFlashMessage(c, utils.FlashError, "Skill not found")
+		c.Redirect(http.StatusFound, "/account")
+		return nil, false
+	}
+	return skill, true
+}
+
 // RenderInboxPage renders the inbox page
 func (h *Handler) RenderInboxPage(c *gin.Context) {
 	session := sessions.Default(c)
@@ -671,6 +726,7 @@ func (h *Handler) RenderMessagePage(c *gin.Context) {
 
 	data := utils.GetTemplateData(c, isAuthenticated)
 	data.Message = message
+	data.ReportReasons = domain.ReportReasons
 	c.HTML(http.StatusOK, "users/message.html", data)
 }
 
@@ -710,13 +766,13 @@ func (h *Handler) RenderProfilesPage(c *gin.Context) {
 	isAuthenticated := userIDStr != nil
 
 	// Search logic
-	searchQuery := c.Query("search_query")
+	filter := profileFilterFromQuery(c)
 
 	// Pagination logic
 	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
 	limit := 3 // Items per page, consistent with Django project
 
-	profiles, totalProfiles, err := h.UserUseCase.GetAllProfiles(searchQuery, page, limit)
+	profiles, totalProfiles, err := h.UserUseCase.GetAllProfiles(filter, viewerID(c), page, limit)
 	if err != nil {
 		log.Printf("Error fetching profiles: %v", err)
 		utils.SetFlashMessage(c, utils.FlashError, "Failed to load profiles")
@@ -728,8 +784,15 @@ func (h *Handler) RenderProfilesPage(c *gin.Context) {
 
 	data := utils.GetTemplateData(c, isAuthenticated)
 	data.Profiles = profiles
-	data.SearchQuery = searchQuery
+	data.SearchQuery = filter.Query
+	data.ProfileFilter = filter
 	data.Pagination = pagination
+	if userID := viewerID(c); userID != uuid.Nil {
+		// Recruiters add developers to their shortlists straight from the search results
+		if data.Shortlists, err = h.ShortlistUseCase.GetShortlists(userID); err != nil {
+			log.Printf("Failed to load shortlists for user %s: %v", userID.String(), err)
+		}
+	}
 	c.HTML(http.StatusOK, "users/index.html", data)
 }
 
@@ -743,33 +806,46 @@ func (h *Handler) RenderUserProfilePage(c *gin.Context) {
 		return
 	}
 
-	profile, err := h.UserUseCase.GetProfileByID(id)
+	h.renderUserProfile(c, id)
+}
+
+// RenderUserProfileByUsernamePage renders a single user profile addressed by username
+func (h *Handler) RenderUserProfileByUsernamePage(c *gin.Context) {
+	username := c.Param("username")
+	profile, moved, err := h.UserUseCase.GetProfileByUsername(username)
 	if err != nil {
-		log.Printf("Profile not found for ID %s: %v", idStr, err)
+		log.Printf("Profile not found for username %s: %v", username, err)
 		utils.SetFlashMessage(c, utils.FlashError, "Profile not found")
 		c.Redirect(http.StatusFound, "/profiles")
 		return
 	}
+	if moved {
+		c.Redirect(http.StatusMovedPermanently, profile.URL())
+		return
+	}
 
+	h.renderUserProfile(c, profile.ID)
+}
+
+// renderUserProfile renders the profile page as seen by the current visitor.
+func (h *Handler) renderUserProfile(c *gin.Context, id uuid.UUID) {
 	session := sessions.Default(c)
 	userIDStr := session.Get("userID")
 	isAuthenticated := userIDStr != nil
-	var currentUserID uuid.UUID
-	if isAuthenticated {
-		currentUserID, _ = uuid.Parse(userIDStr.(string))
+	currentUserID := viewerID(c)
+
+	profile, err := h.UserUseCase.GetVisibleProfile(id, currentUserID)
+	if err != nil {
+		log.Printf("Profile not found for ID %s: %v", id.String(), err)
+		utils.SetFlashMessage(c, utils.FlashError, "Profile not found")
+		c.Redirect(http.StatusFound, "/profiles")
+		return
 	}
 
-	// Filter skills into top and other based on description existence
-	var topSkills []domain.Skill
-	var otherSkills []domain.Skill
+	h.recordView(c, domain.ViewKindProfile, profile.ID, profile.UserID)
 
-	for _, skill := range profile.Skills {
-		if skill.Description != "" {
-			topSkills = append(topSkills, skill)
-		} else {
-			otherSkills = append(otherSkills, skill)
-		}
-	}
+	// Filter skills into top and other based on description existence
+	topSkills, otherSkills := application.SplitSkills(profile.Skills)
 
 	data := utils.GetTemplateData(c, isAuthenticated)
 	data.Profile = *profile // Dereference
@@ -777,6 +853,14 @@ func (h *Handler) RenderUserProfilePage(c *gin.Context) {
 	data.OtherSkills = otherSkills
 	data.CurrentUserID = currentUserID
 	data.IsOwner = (currentUserID == profile.UserID) // Determine if authenticated user is the owner
+	data.IsFollowing = h.ActivityUseCase.IsFollowing(currentUserID, profile.ID)
+	data.ReportReasons = domain.ReportReasons
+	if data.FollowerCount, err = h.ActivityUseCase.CountFollowers(profile.ID); err != nil {
+		log.Printf("Failed to count followers of profile %s: %v", profile.ID.String(), err)
+	}
+	if data.SimilarProfiles, err = h.RecommendationUseCase.GetSimilarProfiles(profile.ID, currentUserID, application.DefaultRecommendationLimit); err != nil {
+		log.Printf("Failed to load similar profiles of profile %s: %v", profile.ID.String(), err)
+	}
 	c.HTML(http.StatusOK, "users/profile.html", data)
 }
 
@@ -797,3 +881,60 @@ func (h *Handler) RenderLoginRegisterPage(c *gin.Context) {
 	data.Page = pageType
 	c.HTML(http.StatusOK, "users/login_register.html", data)
 }
+
+// skillProficiencyFromForm reads the skill level and years of experience fields of a skill form.
+func skillProficiencyFromForm(c *gin.Context) (string, int, error) {
+	level := strings.TrimSpace(c.PostForm("level"))
+	yearsStr := strings.TrimSpace(c.PostForm("years_of_experience"))
+	if yearsStr == "" {
+		return level, 0, nil
+	}
+	years, err := strconv.Atoi(yearsStr)
+	if err != nil {
+		return "", 0, fmt.Errorf("Years of experience must be a whole number")
+	}
+	return level, years, nil
+}
+
+// profileFilterFromQuery reads the developer search filters from the query string.
+func profileFilterFromQuery(c *gin.Context) application.ProfileFilter {
+	return profileFilterFrom(c.Query)
+}
+
+// profileFilterFrom reads the developer search fields through get, which looks up a query or form value.
+func profileFilterFrom(get func(key string) string) application.ProfileFilter {
+	maxSalary, _ := strconv.Atoi(get("max_salary"))
+	return application.ProfileFilter{
+		Query:           get("search_query"),
+		SortBy:          get("sort"),
+		Availability:    get("availability"),
+		WorkArrangement: get("work_arrangement"),
+		Role:            strings.TrimSpace(get("role")),
+		TimeZone:        strings.TrimSpace(get("time_zone")),
+		MaxSalary:       maxSalary,
+		SalaryCurrency:  strings.ToUpper(strings.TrimSpace(get("currency"))),
+	}
+}
+
+// profileFilterQuery encodes a developer search filter as the query string read by profileFilterFromQuery.
+func profileFilterQuery(filter application.ProfileFilter) string {
+	values := url.Values{}
+	fields := map[string]string{
+		"search_query":     filter.Query,
+		"sort":             filter.SortBy,
+		"availability":     filter.Availability,
+		"work_arrangement": filter.WorkArrangement,
+		"role":             filter.Role,
+		"time_zone":        filter.TimeZone,
+		"currency":         filter.SalaryCurrency,
+	}
+	for key, value := range fields {
+		if value != "" {
+			values.Set(key, value)
+		}
+	}
+	if filter.MaxSalary > 0 {
+		values.Set("max_salary", strconv.Itoa(filter.MaxSalary))
+	}
+	return values.Encode()
+}
@@ -19,5 +19,31 @@ document.addEventListener('DOMContentLoaded', function() {
             })
         }
     }
-});
 
+    let skillInput = document.querySelector('[data-skill-catalog]');
+    if (skillInput) {
+        let datalist = document.getElementById(skillInput.getAttribute('list'));
+        let timer;
+        skillInput.addEventListener('input', function() {
+            clearTimeout(timer);
+            let query = this.value.trim();
+            if (!query) {
+                datalist.innerHTML = '';
+                return;
+            }
+            timer = setTimeout(function() {
+                fetch('/api/skill-catalog?q=' + encodeURIComponent(query))
+                    .then(function(response) { return response.json(); })
+                    .then(function(skills) {
+                        datalist.innerHTML = '';
+                        skills.forEach(function(skill) {
+                            let option = document.createElement('option');
+                            option.value = skill.name;
+                            datalist.appendChild(option);
+                        });
+                    })
+                    .catch(function(err) { console.log('skill catalog lookup failed:', err); });
+            }, 200);
+        });
+    }
+});